@@ -0,0 +1,101 @@
+package block
+
+import (
+	"encoding/json"
+
+	"github.com/streamingfast/eth-go"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// rpcArbitrumTransaction holds the fields added by Arbitrum to the transactions of its own types, as returned
+// by Nitro's `eth_getBlockByNumber`
+type rpcArbitrumTransaction struct {
+	RequestID           eth.Hash     `json:"requestId"`
+	TicketID            eth.Hash     `json:"ticketId"`
+	MaxRefund           *eth.Uint256 `json:"maxRefund"`
+	SubmissionFeeRefund *eth.Uint256 `json:"submissionFeeRefund"`
+	RefundTo            eth.Address  `json:"refundTo"`
+	L1BaseFee           *eth.Uint256 `json:"l1BaseFee"`
+	DepositValue        *eth.Uint256 `json:"depositValue"`
+	RetryTo             eth.Address  `json:"retryTo"`
+	RetryValue          *eth.Uint256 `json:"retryValue"`
+	RetryData           eth.Hex      `json:"retryData"`
+	Beneficiary         eth.Address  `json:"beneficiary"`
+	MaxSubmissionFee    *eth.Uint256 `json:"maxSubmissionFee"`
+}
+
+// rpcArbitrumReceipt holds the fields added by Arbitrum to the receipts
+type rpcArbitrumReceipt struct {
+	GasUsedForL1  *eth.Uint64 `json:"gasUsedForL1"`
+	L1BlockNumber *eth.Uint64 `json:"l1BlockNumber"`
+}
+
+// ArbOneRpcToEthBlock converts a block of an Arbitrum chain like RpcToEthBlock, adding the Arbitrum fields
+// of the transactions and receipts
+func ArbOneRpcToEthBlock(in *RpcBlock, receipts map[string]*RpcReceipt, logger *zap.Logger) (*pbeth.Block, map[string]bool) {
+	out, hashesWithoutTo := RpcToEthBlock(in, receipts, logger)
+
+	for _, trace := range out.TransactionTraces {
+		hash := eth.Hash(trace.Hash).Pretty()
+
+		if raw := in.rawTransactions[hash]; raw != nil {
+			var trx rpcArbitrumTransaction
+			if err := json.Unmarshal(raw, &trx); err != nil {
+				logger.Warn("unable to decode arbitrum transaction fields", zap.String("tx_hash", hash), zap.Error(err))
+			} else {
+				trace.Arbitrum = toArbitrumTransaction(&trx)
+			}
+		}
+
+		if receipt := receipts[hash]; receipt != nil && receipt.raw != nil {
+			var arbReceipt rpcArbitrumReceipt
+			if err := json.Unmarshal(receipt.raw, &arbReceipt); err != nil {
+				logger.Warn("unable to decode arbitrum receipt fields", zap.String("tx_hash", hash), zap.Error(err))
+			} else {
+				trace.Receipt.Arbitrum = toArbitrumReceipt(&arbReceipt)
+			}
+		}
+	}
+
+	return out, hashesWithoutTo
+}
+
+// toArbitrumTransaction returns nil for the transactions without any Arbitrum field
+func toArbitrumTransaction(in *rpcArbitrumTransaction) *pbeth.ArbitrumTransaction {
+	out := &pbeth.ArbitrumTransaction{
+		RequestId:           in.RequestID,
+		TicketId:            in.TicketID,
+		MaxRefund:           nonZeroBigInt(in.MaxRefund),
+		SubmissionFeeRefund: nonZeroBigInt(in.SubmissionFeeRefund),
+		RefundTo:            in.RefundTo,
+		L1BaseFee:           nonZeroBigInt(in.L1BaseFee),
+		DepositValue:        nonZeroBigInt(in.DepositValue),
+		RetryTo:             in.RetryTo,
+		RetryValue:          nonZeroBigInt(in.RetryValue),
+		RetryData:           in.RetryData,
+		Beneficiary:         in.Beneficiary,
+		MaxSubmissionFee:    nonZeroBigInt(in.MaxSubmissionFee),
+	}
+
+	if proto.Size(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+func toArbitrumReceipt(in *rpcArbitrumReceipt) *pbeth.ArbitrumReceipt {
+	if in.GasUsedForL1 == nil && in.L1BlockNumber == nil {
+		return nil
+	}
+
+	out := &pbeth.ArbitrumReceipt{}
+	if in.GasUsedForL1 != nil {
+		out.GasUsedForL1 = uint64(*in.GasUsedForL1)
+	}
+	if in.L1BlockNumber != nil {
+		out.L1BlockNumber = uint64(*in.L1BlockNumber)
+	}
+	return out
+}
@@ -0,0 +1,68 @@
+package block
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestArbOneRpcToEthBlock(t *testing.T) {
+	blockJSON := `{
+		"number": "0xa",
+		"hash": "0x8ed13ee1b0c5b4f7e2cdd8bbfb1e4e2ab1f1c9a5b1a4c1f9e3e0b2a5c0e1d7f4",
+		"transactions": [
+			{"hash": "0x74b9554595faea99f11f5310c086acaec4686ef94b30466f966d67071b228b65", "type": "0x2"},
+			{
+				"hash": "0x84b9554595faea99f11f5310c086acaec4686ef94b30466f966d67071b228b65",
+				"type": "0x69",
+				"requestId": "0x00000000000000000000000000000000000000000000000000000000000001a4",
+				"refundTo": "0x63c0c19a282a1b52b07dd5a65b58948a07dae32b",
+				"l1BaseFee": "0x3b9aca00",
+				"depositValue": "0x0",
+				"retryTo": "0x73c0c19a282a1b52b07dd5a65b58948a07dae32b",
+				"retryValue": "0x64",
+				"retryData": "0xcafe",
+				"beneficiary": "0x63c0c19a282a1b52b07dd5a65b58948a07dae32b",
+				"maxSubmissionFee": "0x1000"
+			}
+		]
+	}`
+	receiptsJSON := `[
+		{"transactionHash": "0x74b9554595faea99f11f5310c086acaec4686ef94b30466f966d67071b228b65", "type": "0x2", "status": "0x1", "gasUsed": "0x5208", "gasUsedForL1": "0x1f4", "l1BlockNumber": "0x12d687", "logs": []},
+		{"transactionHash": "0x84b9554595faea99f11f5310c086acaec4686ef94b30466f966d67071b228b65", "type": "0x69", "status": "0x1", "gasUsed": "0x0", "gasUsedForL1": "0x0", "l1BlockNumber": "0x12d687", "logs": []}
+	]`
+
+	var rpcBlock *RpcBlock
+	require.NoError(t, json.Unmarshal([]byte(blockJSON), &rpcBlock))
+
+	var rpcReceipts []*RpcReceipt
+	require.NoError(t, json.Unmarshal([]byte(receiptsJSON), &rpcReceipts))
+	receipts := make(map[string]*RpcReceipt)
+	for _, receipt := range rpcReceipts {
+		receipts[receipt.TransactionHash.Pretty()] = receipt
+	}
+
+	ethBlock, _ := ArbOneRpcToEthBlock(rpcBlock, receipts, zap.NewNop())
+	require.Len(t, ethBlock.TransactionTraces, 2)
+
+	standard := ethBlock.TransactionTraces[0]
+	assert.Nil(t, standard.Arbitrum)
+	assert.Equal(t, &pbeth.ArbitrumReceipt{GasUsedForL1: 500, L1BlockNumber: 1234567}, standard.Receipt.Arbitrum)
+
+	submitRetryable := ethBlock.TransactionTraces[1]
+	assert.Equal(t, pbeth.TransactionTrace_TRX_TYPE_ARBITRUM_SUBMIT_RETRYABLE, submitRetryable.Type)
+	require.NotNil(t, submitRetryable.Arbitrum)
+	assert.Equal(t, []byte(eth.MustNewHash("0x00000000000000000000000000000000000000000000000000000000000001a4")), submitRetryable.Arbitrum.RequestId)
+	assert.Equal(t, []byte(eth.MustNewAddress("0x63c0c19a282a1b52b07dd5a65b58948a07dae32b")), submitRetryable.Arbitrum.RefundTo)
+	assert.Equal(t, []byte{0x3b, 0x9a, 0xca, 0x00}, submitRetryable.Arbitrum.L1BaseFee.Bytes)
+	assert.Nil(t, submitRetryable.Arbitrum.DepositValue)
+	assert.Equal(t, []byte{0x64}, submitRetryable.Arbitrum.RetryValue.Bytes)
+	assert.Equal(t, []byte{0xca, 0xfe}, submitRetryable.Arbitrum.RetryData)
+	assert.Nil(t, submitRetryable.Arbitrum.TicketId)
+	assert.Equal(t, &pbeth.ArbitrumReceipt{L1BlockNumber: 1234567}, submitRetryable.Receipt.Arbitrum)
+}
@@ -14,8 +14,8 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func RpcToEthBlock(in *rpc.Block, receipts map[string]*rpc.TransactionReceipt, logger *zap.Logger) (*pbeth.Block, map[string]bool) {
-	trx, hashesWithoutTo := toFirehoseTraces(in.Transactions, receipts, logger)
+func RpcToEthBlock(in *RpcBlock, receipts map[string]*RpcReceipt, logger *zap.Logger) (*pbeth.Block, map[string]bool) {
+	trx, hashesWithoutTo := toFirehoseTraces(in.Transactions, receipts, in.SetCodeAuthorizations, logger)
 
 	var blobGasUsed *uint64
 	if in.BlobGasUsed != nil {
@@ -49,6 +49,7 @@ func RpcToEthBlock(in *rpc.Block, receipts map[string]*rpc.TransactionReceipt, l
 		TransactionTraces: trx,
 		BalanceChanges:    nil, // not available
 		CodeChanges:       nil, // not available
+		Withdrawals:       toFirehoseWithdrawals(in.Withdrawals),
 		Header: &pbeth.BlockHeader{
 			ParentHash:       in.ParentHash.Bytes(),
 			Coinbase:         in.Miner,
@@ -88,6 +89,23 @@ func toFirehoseUncles(in []eth.Hash) []*pbeth.BlockHeader {
 	return out
 }
 
+func toFirehoseWithdrawals(in []rpc.Withdrawal) []*pbeth.Withdrawal {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]*pbeth.Withdrawal, len(in))
+	for i, withdrawal := range in {
+		out[i] = &pbeth.Withdrawal{
+			Index:          uint64(withdrawal.Index),
+			ValidatorIndex: uint64(withdrawal.Validator),
+			Address:        withdrawal.Address,
+			Amount:         uint64(withdrawal.Amount),
+		}
+	}
+	return out
+}
+
 func toAccessList(in rpc.AccessList) []*pbeth.AccessTuple {
 	out := make([]*pbeth.AccessTuple, len(in))
 	for i, v := range in {
@@ -115,7 +133,7 @@ func (c *counter) next() uint64 {
 	return prev
 }
 
-func convertTrx(transaction *rpc.Transaction, toBytes []byte, ordinal *counter, receipt *rpc.TransactionReceipt) *pbeth.TransactionTrace {
+func convertTrx(transaction *rpc.Transaction, toBytes []byte, ordinal *counter, receipt *RpcReceipt, setCodeAuthorizations []*pbeth.SetCodeAuthorization) *pbeth.TransactionTrace {
 	var out *pbeth.TransactionTrace
 
 	out = &pbeth.TransactionTrace{
@@ -134,6 +152,8 @@ func convertTrx(transaction *rpc.Transaction, toBytes []byte, ordinal *counter,
 		AccessList:   toAccessList(transaction.AccessList),
 		BeginOrdinal: ordinal.next(), // 0 on first trx
 
+		SetCodeAuthorizations: setCodeAuthorizations,
+
 		// MaxFeePerGas:            // not available on RPC
 		// MaxPriorityFeePerGas:    // not available on RPC
 		// ReturnData:              // not available on RPC
@@ -168,7 +188,7 @@ func toFirehoseReceiptStatus(in uint64) pbeth.TransactionTraceStatus {
 	}
 }
 
-func toFirehoseTraces(in *rpc.BlockTransactions, receipts map[string]*rpc.TransactionReceipt, logger *zap.Logger) (traces []*pbeth.TransactionTrace, hashesWithoutTo map[string]bool) {
+func toFirehoseTraces(in *rpc.BlockTransactions, receipts map[string]*RpcReceipt, setCodeAuthorizations map[string][]*pbeth.SetCodeAuthorization, logger *zap.Logger) (traces []*pbeth.TransactionTrace, hashesWithoutTo map[string]bool) {
 	ordinal := &counter{}
 
 	transactions, _ := in.Receipts() //todo: this is confusing, Why is it not call Transactions?
@@ -185,7 +205,7 @@ func toFirehoseTraces(in *rpc.BlockTransactions, receipts map[string]*rpc.Transa
 		}
 
 		receipt := receipts[transactions[i].Hash.Pretty()]
-		pbTrace := convertTrx(&transactions[i], toBytes, ordinal, receipt)
+		pbTrace := convertTrx(&transactions[i], toBytes, ordinal, receipt, setCodeAuthorizations[transactions[i].Hash.Pretty()])
 		if !loggedUnknownReceiptStatus && pbTrace.Status == pbeth.TransactionTraceStatus_UNKNOWN {
 			logger.Warn("receipt status is nil, firehose transaction status will be 'Unknown' (if this is pre-byzantium, you should try using an Erigon endpoint to get the status)", zap.String("tx_hash", hex.EncodeToString(pbTrace.Hash)))
 			loggedUnknownReceiptStatus = true
@@ -196,7 +216,7 @@ func toFirehoseTraces(in *rpc.BlockTransactions, receipts map[string]*rpc.Transa
 	return out, hashesWithoutTo
 }
 
-func toFirehoseReceipts(receipt *rpc.TransactionReceipt, ordinal *counter) *pbeth.TransactionReceipt {
+func toFirehoseReceipts(receipt *RpcReceipt, ordinal *counter) *pbeth.TransactionReceipt {
 	if receipt == nil {
 		return &pbeth.TransactionReceipt{}
 	}
@@ -242,6 +262,19 @@ func BigIntFromEthUint256(in *eth.Uint256) *pbeth.BigInt {
 	return pbeth.BigIntFromBytes(slice)
 }
 
+// nonZeroBigInt is like BigIntFromEthUint256 but returns nil when the value is nil or zero
+func nonZeroBigInt(in *eth.Uint256) *pbeth.BigInt {
+	if in == nil {
+		return nil
+	}
+
+	value := BigIntFromEthUint256(in)
+	if len(value.Bytes) == 0 {
+		return nil
+	}
+	return value
+}
+
 func BigIntFromEthUint256Padded32(in *eth.Uint256) *pbeth.BigInt {
 	if in == nil {
 		return &pbeth.BigInt{}
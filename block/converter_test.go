@@ -42,11 +42,11 @@ func TestConvertTrx(t *testing.T) {
 				val: test.beginOrdinal,
 			}
 
-			receipt := &rpc.TransactionReceipt{
+			receipt := &RpcReceipt{TransactionReceipt: &rpc.TransactionReceipt{
 				Logs: test.logs,
-			}
+			}}
 
-			out := convertTrx(in, nil, ordinal, receipt)
+			out := convertTrx(in, nil, ordinal, receipt, nil)
 
 			i := test.beginOrdinal
 			assert.Equal(t, i, out.BeginOrdinal)
@@ -0,0 +1,85 @@
+package block
+
+import (
+	"encoding/json"
+
+	"github.com/streamingfast/eth-go"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// rpcOptimismTransaction holds the fields added by the OP stack to the deposit transactions, as returned by
+// op-geth's `eth_getBlockByNumber`
+type rpcOptimismTransaction struct {
+	SourceHash eth.Hash     `json:"sourceHash"`
+	Mint       *eth.Uint256 `json:"mint"`
+	IsSystemTx bool         `json:"isSystemTx"`
+}
+
+// rpcOptimismReceipt holds the fields added by the OP stack to the receipts
+type rpcOptimismReceipt struct {
+	L1Fee                 *eth.Uint256 `json:"l1Fee"`
+	L1GasUsed             *eth.Uint256 `json:"l1GasUsed"`
+	L1GasPrice            *eth.Uint256 `json:"l1GasPrice"`
+	L1FeeScalar           string       `json:"l1FeeScalar"`
+	L1BlobBaseFee         *eth.Uint256 `json:"l1BlobBaseFee"`
+	L1BaseFeeScalar       *eth.Uint64  `json:"l1BaseFeeScalar"`
+	L1BlobBaseFeeScalar   *eth.Uint64  `json:"l1BlobBaseFeeScalar"`
+	DepositNonce          *eth.Uint64  `json:"depositNonce"`
+	DepositReceiptVersion *eth.Uint64  `json:"depositReceiptVersion"`
+}
+
+// OptimismRpcToEthBlock converts a block of an OP stack chain like RpcToEthBlock, adding the fields of the
+// deposit transactions and the L1 fee and deposit fields of the receipts
+func OptimismRpcToEthBlock(in *RpcBlock, receipts map[string]*RpcReceipt, logger *zap.Logger) (*pbeth.Block, map[string]bool) {
+	out, hashesWithoutTo := RpcToEthBlock(in, receipts, logger)
+
+	for _, trace := range out.TransactionTraces {
+		hash := eth.Hash(trace.Hash).Pretty()
+
+		if raw := in.rawTransactions[hash]; raw != nil && trace.Type == pbeth.TransactionTrace_TRX_TYPE_OPTIMISM_DEPOSIT {
+			var trx rpcOptimismTransaction
+			if err := json.Unmarshal(raw, &trx); err != nil {
+				logger.Warn("unable to decode optimism deposit transaction fields", zap.String("tx_hash", hash), zap.Error(err))
+			} else {
+				trace.Optimism = &pbeth.OptimismTransaction{
+					SourceHash: trx.SourceHash,
+					Mint:       nonZeroBigInt(trx.Mint),
+					IsSystemTx: trx.IsSystemTx,
+				}
+			}
+		}
+
+		if receipt := receipts[hash]; receipt != nil && receipt.raw != nil {
+			var opReceipt rpcOptimismReceipt
+			if err := json.Unmarshal(receipt.raw, &opReceipt); err != nil {
+				logger.Warn("unable to decode optimism receipt fields", zap.String("tx_hash", hash), zap.Error(err))
+			} else {
+				trace.Receipt.Optimism = toOptimismReceipt(&opReceipt)
+			}
+		}
+	}
+
+	return out, hashesWithoutTo
+}
+
+// toOptimismReceipt returns nil for the receipts without any OP stack field
+func toOptimismReceipt(in *rpcOptimismReceipt) *pbeth.OptimismReceipt {
+	out := &pbeth.OptimismReceipt{
+		L1Fee:                 nonZeroBigInt(in.L1Fee),
+		L1GasUsed:             nonZeroBigInt(in.L1GasUsed),
+		L1GasPrice:            nonZeroBigInt(in.L1GasPrice),
+		L1FeeScalar:           in.L1FeeScalar,
+		L1BlobBaseFee:         nonZeroBigInt(in.L1BlobBaseFee),
+		L1BaseFeeScalar:       (*uint64)(in.L1BaseFeeScalar),
+		L1BlobBaseFeeScalar:   (*uint64)(in.L1BlobBaseFeeScalar),
+		DepositNonce:          (*uint64)(in.DepositNonce),
+		DepositReceiptVersion: (*uint64)(in.DepositReceiptVersion),
+	}
+
+	if proto.Size(out) == 0 {
+		return nil
+	}
+	return out
+}
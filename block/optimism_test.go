@@ -0,0 +1,70 @@
+package block
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestOptimismRpcToEthBlock(t *testing.T) {
+	blockJSON := `{
+		"number": "0xa",
+		"hash": "0x8ed13ee1b0c5b4f7e2cdd8bbfb1e4e2ab1f1c9a5b1a4c1f9e3e0b2a5c0e1d7f4",
+		"transactions": [
+			{
+				"hash": "0x74b9554595faea99f11f5310c086acaec4686ef94b30466f966d67071b228b65",
+				"type": "0x7e",
+				"sourceHash": "0x9d9b5a1b8e1b4e0c2b5a8c6b8e1d7f4c3b2a1908f7e6d5c4b3a2918f7e6d5c4b",
+				"mint": "0x2386f26fc10000",
+				"isSystemTx": false
+			},
+			{"hash": "0x84b9554595faea99f11f5310c086acaec4686ef94b30466f966d67071b228b65", "type": "0x2"}
+		]
+	}`
+	receiptsJSON := `[
+		{"transactionHash": "0x74b9554595faea99f11f5310c086acaec4686ef94b30466f966d67071b228b65", "type": "0x7e", "status": "0x1", "gasUsed": "0xb0a1", "depositNonce": "0x0", "depositReceiptVersion": "0x1", "logs": []},
+		{"transactionHash": "0x84b9554595faea99f11f5310c086acaec4686ef94b30466f966d67071b228b65", "type": "0x2", "status": "0x1", "gasUsed": "0x5208", "l1Fee": "0x1d1a94a2000", "l1GasUsed": "0x640", "l1GasPrice": "0x3b9aca00", "l1BlobBaseFee": "0x1", "l1BaseFeeScalar": "0x8dd", "l1BlobBaseFeeScalar": "0x101c12", "logs": []}
+	]`
+
+	var rpcBlock *RpcBlock
+	require.NoError(t, json.Unmarshal([]byte(blockJSON), &rpcBlock))
+
+	var rpcReceipts []*RpcReceipt
+	require.NoError(t, json.Unmarshal([]byte(receiptsJSON), &rpcReceipts))
+	receipts := make(map[string]*RpcReceipt)
+	for _, receipt := range rpcReceipts {
+		receipts[receipt.TransactionHash.Pretty()] = receipt
+	}
+
+	ethBlock, _ := OptimismRpcToEthBlock(rpcBlock, receipts, zap.NewNop())
+	require.Len(t, ethBlock.TransactionTraces, 2)
+
+	deposit := ethBlock.TransactionTraces[0]
+	assert.Equal(t, pbeth.TransactionTrace_TRX_TYPE_OPTIMISM_DEPOSIT, deposit.Type)
+	require.NotNil(t, deposit.Optimism)
+	assert.Equal(t, []byte(eth.MustNewHash("0x9d9b5a1b8e1b4e0c2b5a8c6b8e1d7f4c3b2a1908f7e6d5c4b3a2918f7e6d5c4b")), deposit.Optimism.SourceHash)
+	assert.Equal(t, []byte{0x23, 0x86, 0xf2, 0x6f, 0xc1, 0x00, 0x00}, deposit.Optimism.Mint.Bytes)
+	assert.False(t, deposit.Optimism.IsSystemTx)
+
+	require.NotNil(t, deposit.Receipt.Optimism)
+	require.NotNil(t, deposit.Receipt.Optimism.DepositNonce)
+	assert.Equal(t, uint64(0), *deposit.Receipt.Optimism.DepositNonce)
+	assert.Equal(t, uint64(1), *deposit.Receipt.Optimism.DepositReceiptVersion)
+	assert.Nil(t, deposit.Receipt.Optimism.L1Fee)
+
+	dynamicFee := ethBlock.TransactionTraces[1]
+	assert.Nil(t, dynamicFee.Optimism)
+	require.NotNil(t, dynamicFee.Receipt.Optimism)
+	assert.Equal(t, []byte{0x01, 0xd1, 0xa9, 0x4a, 0x20, 0x00}, dynamicFee.Receipt.Optimism.L1Fee.Bytes)
+	assert.Equal(t, []byte{0x06, 0x40}, dynamicFee.Receipt.Optimism.L1GasUsed.Bytes)
+	assert.Equal(t, []byte{0x3b, 0x9a, 0xca, 0x00}, dynamicFee.Receipt.Optimism.L1GasPrice.Bytes)
+	assert.Equal(t, []byte{0x01}, dynamicFee.Receipt.Optimism.L1BlobBaseFee.Bytes)
+	assert.Equal(t, uint64(0x8dd), *dynamicFee.Receipt.Optimism.L1BaseFeeScalar)
+	assert.Equal(t, uint64(0x101c12), *dynamicFee.Receipt.Optimism.L1BlobBaseFeeScalar)
+	assert.Nil(t, dynamicFee.Receipt.Optimism.DepositNonce)
+}
@@ -0,0 +1,138 @@
+package block
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/holiman/uint256"
+	"github.com/streamingfast/eth-go"
+	"github.com/streamingfast/eth-go/rlp"
+	"github.com/streamingfast/eth-go/rpc"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+)
+
+// RpcBlock is a block returned by `eth_getBlockByNumber` with full transactions, decoded as an rpc.Block
+// along with the transaction fields that rpc.Transaction doesn't know about.
+type RpcBlock struct {
+	*rpc.Block
+
+	// SetCodeAuthorizations holds the authorization list (EIP-7702) of the set code transactions of the
+	// block, keyed by transaction hash (`0x` prefixed)
+	SetCodeAuthorizations map[string][]*pbeth.SetCodeAuthorization
+
+	// rawTransactions holds the full transactions as returned by the endpoint, keyed by transaction hash
+	// (`0x` prefixed), for the chain-specific converters to decode their own fields
+	rawTransactions map[string]json.RawMessage
+}
+
+type rpcSetCodeTransaction struct {
+	Hash              eth.Hash                  `json:"hash"`
+	AuthorizationList []rpcSetCodeAuthorization `json:"authorizationList"`
+}
+
+type rpcSetCodeAuthorization struct {
+	ChainID *eth.Uint256 `json:"chainId"`
+	Address eth.Address  `json:"address"`
+	Nonce   eth.Uint64   `json:"nonce"`
+	YParity eth.Uint64   `json:"yParity"`
+	R       *eth.Uint256 `json:"r"`
+	S       *eth.Uint256 `json:"s"`
+}
+
+func (b *RpcBlock) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &b.Block); err != nil {
+		return err
+	}
+
+	var extras struct {
+		Transactions []json.RawMessage `json:"transactions"`
+	}
+	if err := json.Unmarshal(data, &extras); err != nil {
+		return err
+	}
+
+	for _, raw := range extras.Transactions {
+		if len(raw) == 0 || raw[0] != '{' {
+			// block fetched without full transactions, only hashes are available
+			continue
+		}
+
+		var trx rpcSetCodeTransaction
+		if err := json.Unmarshal(raw, &trx); err != nil {
+			return fmt.Errorf("decoding set code transaction: %w", err)
+		}
+
+		if b.rawTransactions == nil {
+			b.rawTransactions = make(map[string]json.RawMessage)
+		}
+		b.rawTransactions[trx.Hash.Pretty()] = raw
+		if len(trx.AuthorizationList) == 0 {
+			continue
+		}
+
+		if b.SetCodeAuthorizations == nil {
+			b.SetCodeAuthorizations = make(map[string][]*pbeth.SetCodeAuthorization)
+		}
+		b.SetCodeAuthorizations[trx.Hash.Pretty()] = toSetCodeAuthorizations(trx.AuthorizationList)
+	}
+	return nil
+}
+
+func toSetCodeAuthorizations(in []rpcSetCodeAuthorization) []*pbeth.SetCodeAuthorization {
+	out := make([]*pbeth.SetCodeAuthorization, len(in))
+	for i, authorization := range in {
+		out[i] = &pbeth.SetCodeAuthorization{
+			ChainId: BigIntFromEthUint256(authorization.ChainID).Bytes,
+			Address: authorization.Address,
+			Nonce:   uint64(authorization.Nonce),
+			V:       uint32(authorization.YParity),
+			R:       uint256Bytes32(authorization.R),
+			S:       uint256Bytes32(authorization.S),
+		}
+
+		if authority, err := recoverAuthority(out[i]); err == nil {
+			out[i].Authority = authority
+		}
+	}
+	return out
+}
+
+// secp256k1HalfN is half the order of the secp256k1 curve, EIP-7702 authorizations with a greater s are invalid
+var secp256k1HalfN, _ = new(big.Int).SetString("7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a0", 16)
+
+// recoverAuthority returns the address that signed the authorization, the signed message is
+// `keccak256(0x05 || rlp([chain_id, address, nonce]))`
+func recoverAuthority(authorization *pbeth.SetCodeAuthorization) (eth.Address, error) {
+	if authorization.V > 1 {
+		return nil, fmt.Errorf("invalid y-parity %d", authorization.V)
+	}
+	if new(big.Int).SetBytes(authorization.S).Cmp(secp256k1HalfN) > 0 {
+		return nil, fmt.Errorf("invalid s value %x, greater than secp256k1n/2", authorization.S)
+	}
+
+	payload, err := rlp.Encode([]interface{}{
+		new(big.Int).SetBytes(authorization.ChainId),
+		authorization.Address,
+		authorization.Nonce,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encoding authorization: %w", err)
+	}
+
+	var signature eth.Signature
+	signature[0] = 27 + byte(authorization.V)
+	copy(signature[1:33], authorization.R)
+	copy(signature[33:65], authorization.S)
+
+	return signature.Recover(eth.Keccak256([]byte{0x05}, payload))
+}
+
+func uint256Bytes32(in *eth.Uint256) []byte {
+	if in == nil {
+		return nil
+	}
+
+	in32 := (*uint256.Int)(in).Bytes32()
+	return in32[:]
+}
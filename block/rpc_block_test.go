@@ -0,0 +1,86 @@
+package block
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRpcBlock_SetCodeAuthorizations(t *testing.T) {
+	// The authorizations are signed by the well-known development key 0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80
+	// (address 0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266), with an EIP-7702 implementation independent from
+	// recoverAuthority. The fourth one is the first with the malleated signature (s' = n - s, flipped y-parity),
+	// which recovers the same address but must be rejected as its s is greater than secp256k1n/2.
+	blockJSON := `{
+		"number": "0xa",
+		"hash": "0x8ed13ee1b0c5b4f7e2cdd8bbfb1e4e2ab1f1c9a5b1a4c1f9e3e0b2a5c0e1d7f4",
+		"transactions": [
+			{"hash": "0x74b9554595faea99f11f5310c086acaec4686ef94b30466f966d67071b228b65", "type": "0x2"},
+			{
+				"hash": "0x84b9554595faea99f11f5310c086acaec4686ef94b30466f966d67071b228b65",
+				"type": "0x4",
+				"authorizationList": [
+					{"chainId": "0x1", "address": "0x63c0c19a282a1b52b07dd5a65b58948a07dae32b", "nonce": "0x7", "yParity": "0x1", "r": "0x5d537baff93620f2f7de88d8bb2c66b578595543f263c7f3f6aedc187326ac98", "s": "0x527efd56f54a66298a59b1a82b0b09e3423dabb8ec13a954a8f0ad9166eacb8d"},
+					{"chainId": "0x0", "address": "0x63c0c19a282a1b52b07dd5a65b58948a07dae32b", "nonce": "0x0", "yParity": "0x0", "r": "0xee51171fd2c8a0a83f4ac09c9b4bc5755a81614b29613eda05a180688f674ff", "s": "0x6e21ebdaa988cbb71bf3f7e87749a11cb097e1d9e7b6b65a9c129376e8c2c80e"},
+					{"chainId": "0x100000000000000000000", "address": "0x63c0c19a282a1b52b07dd5a65b58948a07dae32b", "nonce": "0x2a", "yParity": "0x1", "r": "0xfdd6816a164ea4d72126f7375a7dc1d70e8df9c52e3dad26e5d7a6c7b386d24a", "s": "0x40010c08ce9ab435280eb8d0132a14cd5cc693cbe433b4329ff646f610128c8a"},
+					{"chainId": "0x1", "address": "0x63c0c19a282a1b52b07dd5a65b58948a07dae32b", "nonce": "0x7", "yParity": "0x0", "r": "0x5d537baff93620f2f7de88d8bb2c66b578595543f263c7f3f6aedc187326ac98", "s": "0xad8102a90ab599d675a64e57d4f4f61b7871312dc334f6e716e1b0fb694b75b4"},
+					{"chainId": "0x0", "address": "0x0000000000000000000000000000000000000000", "nonce": "0x0", "yParity": "0x2", "r": "0x1", "s": "0x1"}
+				]
+			}
+		]
+	}`
+
+	var rpcBlock *RpcBlock
+	require.NoError(t, json.Unmarshal([]byte(blockJSON), &rpcBlock))
+	require.Len(t, rpcBlock.Transactions.Transactions, 2)
+
+	ethBlock, _ := RpcToEthBlock(rpcBlock, nil, zap.NewNop())
+	require.Len(t, ethBlock.TransactionTraces, 2)
+	assert.Empty(t, ethBlock.TransactionTraces[0].SetCodeAuthorizations)
+
+	authorizations := ethBlock.TransactionTraces[1].SetCodeAuthorizations
+	require.Len(t, authorizations, 5)
+
+	delegate := eth.MustNewAddress("0x63c0c19a282a1b52b07dd5a65b58948a07dae32b")
+	authority := eth.MustNewAddress("0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266")
+
+	assert.Equal(t, []byte{0x01}, authorizations[0].ChainId)
+	assert.Equal(t, []byte(delegate), authorizations[0].Address)
+	assert.Equal(t, uint64(7), authorizations[0].Nonce)
+	assert.Len(t, authorizations[0].R, 32)
+	assert.Equal(t, []byte(authority), authorizations[0].Authority)
+
+	assert.Equal(t, []byte(authority), authorizations[1].Authority, "chain id 0")
+	assert.Equal(t, []byte(authority), authorizations[2].Authority, "chain id wider than 64 bits")
+	assert.Len(t, authorizations[1].R, 32, "r is left-padded to 32 bytes")
+
+	assert.Nil(t, authorizations[3].Authority, "high s cannot be recovered")
+	assert.Nil(t, authorizations[4].Authority, "invalid y-parity cannot be recovered")
+}
+
+func TestRpcBlock_Withdrawals(t *testing.T) {
+	blockJSON := `{
+		"number": "0xa",
+		"hash": "0x8ed13ee1b0c5b4f7e2cdd8bbfb1e4e2ab1f1c9a5b1a4c1f9e3e0b2a5c0e1d7f4",
+		"transactions": [],
+		"withdrawals": [
+			{"index": "0x2a", "validatorIndex": "0x3e8", "address": "0x63c0c19a282a1b52b07dd5a65b58948a07dae32b", "amount": "0xde0b6b3"}
+		]
+	}`
+
+	var rpcBlock *RpcBlock
+	require.NoError(t, json.Unmarshal([]byte(blockJSON), &rpcBlock))
+
+	ethBlock, _ := RpcToEthBlock(rpcBlock, nil, zap.NewNop())
+	require.Len(t, ethBlock.Withdrawals, 1)
+
+	withdrawal := ethBlock.Withdrawals[0]
+	assert.Equal(t, uint64(42), withdrawal.Index)
+	assert.Equal(t, uint64(1000), withdrawal.ValidatorIndex)
+	assert.Equal(t, []byte(eth.MustNewAddress("0x63c0c19a282a1b52b07dd5a65b58948a07dae32b")), withdrawal.Address)
+	assert.Equal(t, uint64(232830643), withdrawal.Amount)
+}
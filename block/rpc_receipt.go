@@ -0,0 +1,25 @@
+package block
+
+import (
+	"encoding/json"
+
+	"github.com/streamingfast/eth-go/rpc"
+)
+
+// RpcReceipt is a receipt returned by `eth_getTransactionReceipt` or `eth_getBlockReceipts`, decoded as an
+// rpc.TransactionReceipt. The raw receipt is kept for the chain-specific converters to decode the fields
+// that rpc.TransactionReceipt doesn't know about.
+type RpcReceipt struct {
+	*rpc.TransactionReceipt
+
+	raw json.RawMessage
+}
+
+func (r *RpcReceipt) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.TransactionReceipt); err != nil {
+		return err
+	}
+
+	r.raw = append(json.RawMessage(nil), data...)
+	return nil
+}
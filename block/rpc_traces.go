@@ -0,0 +1,217 @@
+package block
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/streamingfast/eth-go"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+)
+
+// RpcCallFrame is a call as returned by the `callTracer` of `debug_traceBlockByHash`, the frame of a
+// transaction is its root call
+type RpcCallFrame struct {
+	Type    string          `json:"type"`
+	From    eth.Address     `json:"from"`
+	To      eth.Address     `json:"to"`
+	Value   *eth.Uint256    `json:"value"`
+	Gas     eth.Uint64      `json:"gas"`
+	GasUsed eth.Uint64      `json:"gasUsed"`
+	Input   eth.Hex         `json:"input"`
+	Output  eth.Hex         `json:"output"`
+	Error   string          `json:"error"`
+	Calls   []*RpcCallFrame `json:"calls"`
+}
+
+// RpcStateDiff is the state of the accounts modified by a transaction as returned by the `prestateTracer` of
+// `debug_traceBlockByHash` in diff mode. The post state only holds the fields that changed, storage slots
+// present in the pre state but not in the post state were cleared.
+type RpcStateDiff struct {
+	Pre  map[string]*RpcAccountState `json:"pre"`
+	Post map[string]*RpcAccountState `json:"post"`
+}
+
+type RpcAccountState struct {
+	Balance *eth.Uint256        `json:"balance"`
+	Nonce   uint64              `json:"nonce"`
+	Code    eth.Hex             `json:"code"`
+	Storage map[string]eth.Hash `json:"storage"`
+}
+
+// AddCallTraces turns a block converted from RPC into a DETAILLEVEL_TRACE block, each transaction trace
+// gets the calls of its frame and, when stateDiffs is not nil, the changes of its state diff on its root call.
+// Frames and state diffs are in the order of the block transactions.
+func AddCallTraces(blk *pbeth.Block, frames []*RpcCallFrame, stateDiffs []*RpcStateDiff) error {
+	if len(frames) != len(blk.TransactionTraces) {
+		return fmt.Errorf("got %d call traces for %d transactions", len(frames), len(blk.TransactionTraces))
+	}
+	if stateDiffs != nil && len(stateDiffs) != len(blk.TransactionTraces) {
+		return fmt.Errorf("got %d state diffs for %d transactions", len(stateDiffs), len(blk.TransactionTraces))
+	}
+
+	for i, trace := range blk.TransactionTraces {
+		if frames[i] == nil {
+			return fmt.Errorf("missing call trace of transaction %s", eth.Hash(trace.Hash).Pretty())
+		}
+
+		trace.Calls = toFirehoseCalls(frames[i])
+		trace.ReturnData = frames[i].Output
+
+		if stateDiffs != nil && stateDiffs[i] != nil {
+			if err := addStateChanges(trace.Calls[0], stateDiffs[i]); err != nil {
+				return fmt.Errorf("state diff of transaction %s: %w", eth.Hash(trace.Hash).Pretty(), err)
+			}
+		}
+	}
+
+	blk.DetailLevel = pbeth.Block_DETAILLEVEL_TRACE
+	return nil
+}
+
+// toFirehoseCalls flattens the frame in execution order, the root call has index 1 like in extended blocks.
+// `SELFDESTRUCT` frames are not calls, they flag their parent call as a suicide.
+func toFirehoseCalls(root *RpcCallFrame) []*pbeth.Call {
+	var out []*pbeth.Call
+
+	var walk func(frame *RpcCallFrame, parent *pbeth.Call)
+	walk = func(frame *RpcCallFrame, parent *pbeth.Call) {
+		if frame.Type == "SELFDESTRUCT" {
+			if parent != nil {
+				parent.Suicide = true
+			}
+			return
+		}
+
+		call := &pbeth.Call{
+			Index:          uint32(len(out) + 1),
+			CallType:       toFirehoseCallType(frame.Type),
+			Caller:         frame.From,
+			Address:        frame.To,
+			Value:          nonZeroBigInt(frame.Value),
+			GasLimit:       uint64(frame.Gas),
+			GasConsumed:    uint64(frame.GasUsed),
+			ReturnData:     frame.Output,
+			Input:          frame.Input,
+			StatusFailed:   frame.Error != "",
+			StatusReverted: frame.Error == "execution reverted",
+			FailureReason:  frame.Error,
+		}
+		if parent != nil {
+			call.ParentIndex = parent.Index
+			call.Depth = parent.Depth + 1
+			call.StateReverted = parent.StateReverted
+		}
+		call.StateReverted = call.StateReverted || call.StatusFailed
+
+		out = append(out, call)
+		for _, child := range frame.Calls {
+			walk(child, call)
+		}
+	}
+	walk(root, nil)
+
+	return out
+}
+
+func toFirehoseCallType(in string) pbeth.CallType {
+	switch in {
+	case "CALL":
+		return pbeth.CallType_CALL
+	case "CALLCODE":
+		return pbeth.CallType_CALLCODE
+	case "DELEGATECALL":
+		return pbeth.CallType_DELEGATE
+	case "STATICCALL":
+		return pbeth.CallType_STATIC
+	case "CREATE", "CREATE2":
+		return pbeth.CallType_CREATE
+	default:
+		return pbeth.CallType_UNSPECIFIED
+	}
+}
+
+// addStateChanges records the changes of the state diff on the call, accounts and storage keys are
+// sorted to produce deterministic blocks. Accounts missing from the post state were deleted, their
+// changes are not recorded.
+func addStateChanges(call *pbeth.Call, diff *RpcStateDiff) error {
+	for _, key := range sortedKeys(diff.Post) {
+		address, err := eth.NewAddress(key)
+		if err != nil {
+			return fmt.Errorf("invalid address %q: %w", key, err)
+		}
+
+		pre := diff.Pre[key]
+		if pre == nil {
+			pre = &RpcAccountState{}
+		}
+		post := diff.Post[key]
+
+		if post.Balance != nil {
+			call.BalanceChanges = append(call.BalanceChanges, &pbeth.BalanceChange{
+				Address:  address,
+				OldValue: nonZeroBigInt(pre.Balance),
+				NewValue: BigIntFromEthUint256(post.Balance),
+				Reason:   pbeth.BalanceChange_REASON_UNKNOWN,
+			})
+		}
+
+		if post.Nonce != 0 && post.Nonce != pre.Nonce {
+			call.NonceChanges = append(call.NonceChanges, &pbeth.NonceChange{
+				Address:  address,
+				OldValue: pre.Nonce,
+				NewValue: post.Nonce,
+			})
+		}
+
+		if post.Code != nil && !bytes.Equal(pre.Code, post.Code) {
+			call.CodeChanges = append(call.CodeChanges, &pbeth.CodeChange{
+				Address: address,
+				OldHash: eth.Keccak256(pre.Code),
+				OldCode: pre.Code,
+				NewHash: eth.Keccak256(post.Code),
+				NewCode: post.Code,
+			})
+		}
+
+		slots := make(map[string]bool)
+		for slot := range pre.Storage {
+			slots[slot] = true
+		}
+		for slot := range post.Storage {
+			slots[slot] = true
+		}
+		for _, slot := range sortedKeys(slots) {
+			key, err := eth.NewHash(slot)
+			if err != nil {
+				return fmt.Errorf("invalid storage key %q: %w", slot, err)
+			}
+
+			newValue := post.Storage[slot]
+			if newValue == nil {
+				newValue = make(eth.Hash, 32)
+			}
+			oldValue := pre.Storage[slot]
+			if oldValue == nil {
+				oldValue = make(eth.Hash, 32)
+			}
+
+			call.StorageChanges = append(call.StorageChanges, &pbeth.StorageChange{
+				Address:  address,
+				Key:      key,
+				OldValue: oldValue,
+				NewValue: newValue,
+			})
+		}
+	}
+	return nil
+}
+
+func sortedKeys[T any](in map[string]T) []string {
+	out := make([]string, 0, len(in))
+	for key := range in {
+		out = append(out, key)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,109 @@
+package block
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddCallTraces(t *testing.T) {
+	sender := eth.MustNewAddress("0x1111111111111111111111111111111111111111")
+	router := eth.MustNewAddress("0x2222222222222222222222222222222222222222")
+	pool := eth.MustNewAddress("0x3333333333333333333333333333333333333333")
+
+	var frame *RpcCallFrame
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"type": "CALL", "from": "0x1111111111111111111111111111111111111111", "to": "0x2222222222222222222222222222222222222222",
+		"value": "0xde0b6b3a7640000", "gas": "0x30000", "gasUsed": "0x12000", "input": "0x38ed1739", "output": "0x01",
+		"calls": [
+			{
+				"type": "DELEGATECALL", "from": "0x2222222222222222222222222222222222222222", "to": "0x3333333333333333333333333333333333333333",
+				"gas": "0x20000", "gasUsed": "0x800", "input": "0x022c0d9f", "error": "execution reverted",
+				"calls": [{"type": "STATICCALL", "from": "0x2222222222222222222222222222222222222222", "to": "0x3333333333333333333333333333333333333333", "gas": "0x100", "gasUsed": "0x10", "input": "0x0902f1ac"}]
+			},
+			{"type": "SELFDESTRUCT", "from": "0x2222222222222222222222222222222222222222", "to": "0x1111111111111111111111111111111111111111", "value": "0x0"}
+		]
+	}`), &frame))
+
+	var stateDiff *RpcStateDiff
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"pre": {
+			"0x1111111111111111111111111111111111111111": {"balance": "0x1000", "nonce": 4},
+			"0x3333333333333333333333333333333333333333": {"balance": "0x0", "storage": {
+				"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000005",
+				"0x0000000000000000000000000000000000000000000000000000000000000002": "0x0000000000000000000000000000000000000000000000000000000000000006"
+			}}
+		},
+		"post": {
+			"0x1111111111111111111111111111111111111111": {"balance": "0x800", "nonce": 5},
+			"0x3333333333333333333333333333333333333333": {"storage": {
+				"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000007"
+			}}
+		}
+	}`), &stateDiff))
+
+	blk := &pbeth.Block{DetailLevel: pbeth.Block_DETAILLEVEL_BASE, TransactionTraces: []*pbeth.TransactionTrace{{Hash: eth.MustNewHash("0x01")}}}
+	require.NoError(t, AddCallTraces(blk, []*RpcCallFrame{frame}, []*RpcStateDiff{stateDiff}))
+	assert.Equal(t, pbeth.Block_DETAILLEVEL_TRACE, blk.DetailLevel)
+
+	trace := blk.TransactionTraces[0]
+	assert.Equal(t, []byte{0x01}, trace.ReturnData)
+
+	calls := trace.Calls
+	require.Len(t, calls, 3)
+
+	root := calls[0]
+	assert.Equal(t, uint32(1), root.Index)
+	assert.Equal(t, uint32(0), root.Depth)
+	assert.Equal(t, pbeth.CallType_CALL, root.CallType)
+	assert.Equal(t, []byte(sender), root.Caller)
+	assert.Equal(t, []byte(router), root.Address)
+	assert.Equal(t, "1000000000000000000", root.Value.Native().String())
+	assert.Equal(t, uint64(0x30000), root.GasLimit)
+	assert.Equal(t, uint64(0x12000), root.GasConsumed)
+	assert.True(t, root.Suicide)
+	assert.False(t, root.StateReverted)
+
+	delegate := calls[1]
+	assert.Equal(t, uint32(2), delegate.Index)
+	assert.Equal(t, uint32(1), delegate.ParentIndex)
+	assert.Equal(t, uint32(1), delegate.Depth)
+	assert.Equal(t, pbeth.CallType_DELEGATE, delegate.CallType)
+	assert.Nil(t, delegate.Value)
+	assert.True(t, delegate.StatusFailed)
+	assert.True(t, delegate.StatusReverted)
+	assert.True(t, delegate.StateReverted)
+	assert.Equal(t, "execution reverted", delegate.FailureReason)
+
+	static := calls[2]
+	assert.Equal(t, uint32(2), static.ParentIndex)
+	assert.Equal(t, uint32(2), static.Depth)
+	assert.Equal(t, pbeth.CallType_STATIC, static.CallType)
+	assert.False(t, static.StatusFailed)
+	assert.True(t, static.StateReverted, "child of a reverted call")
+
+	require.Len(t, root.BalanceChanges, 1)
+	assert.Equal(t, []byte(sender), root.BalanceChanges[0].Address)
+	assert.Equal(t, "4096", root.BalanceChanges[0].OldValue.Native().String())
+	assert.Equal(t, "2048", root.BalanceChanges[0].NewValue.Native().String())
+
+	require.Len(t, root.NonceChanges, 1)
+	assert.Equal(t, uint64(4), root.NonceChanges[0].OldValue)
+	assert.Equal(t, uint64(5), root.NonceChanges[0].NewValue)
+
+	require.Len(t, root.StorageChanges, 2)
+	assert.Equal(t, []byte(pool), root.StorageChanges[0].Address)
+	assert.Equal(t, byte(5), root.StorageChanges[0].OldValue[31])
+	assert.Equal(t, byte(7), root.StorageChanges[0].NewValue[31])
+	assert.Equal(t, byte(6), root.StorageChanges[1].OldValue[31])
+	assert.Equal(t, make([]byte, 32), root.StorageChanges[1].NewValue, "slot cleared")
+}
+
+func TestAddCallTraces_MissingTraces(t *testing.T) {
+	blk := &pbeth.Block{TransactionTraces: []*pbeth.TransactionTrace{{}, {}}}
+	require.Error(t, AddCallTraces(blk, []*RpcCallFrame{{Type: "CALL"}}, nil))
+}
@@ -11,19 +11,22 @@ import (
 	"github.com/streamingfast/firehose-ethereum/block"
 )
 
+// ArbOneBlockFetcher fetches the blocks of Arbitrum chains, with the Arbitrum fields of their transactions
+// and receipts
 type ArbOneBlockFetcher struct {
-	fetcher *BlockFetcher
+	*BlockFetcher
 }
 
-func NewArbOneBlockFetcher(intervalBetweenFetch time.Duration, latestBlockRetryInterval time.Duration, logger *zap.Logger) *OptimismBlockFetcher {
-	fetcher := NewBlockFetcher(intervalBetweenFetch, latestBlockRetryInterval, block.RpcToEthBlock, logger)
-	return &OptimismBlockFetcher{
-		fetcher: fetcher,
+func NewArbOneBlockFetcher(intervalBetweenFetch time.Duration, latestBlockRetryInterval time.Duration, logger *zap.Logger) *ArbOneBlockFetcher {
+	fetcher := NewBlockFetcher(intervalBetweenFetch, latestBlockRetryInterval, block.ArbOneRpcToEthBlock, logger)
+	return &ArbOneBlockFetcher{
+		BlockFetcher: fetcher,
 	}
 }
 
 func (f *ArbOneBlockFetcher) PollingInterval() time.Duration { return 1 * time.Second }
 
-func (f *ArbOneBlockFetcher) Fetch(ctx context.Context, rpcClient *rpc.Client, blockNum uint64) (*pbbstream.Block, error) {
-	return f.fetcher.Fetch(ctx, rpcClient, blockNum)
+func (f *ArbOneBlockFetcher) Fetch(ctx context.Context, rpcClient *rpc.Client, blockNum uint64) (b *pbbstream.Block, skipped bool, err error) {
+	blk, err := f.BlockFetcher.Fetch(ctx, rpcClient, blockNum)
+	return blk, false, err
 }
@@ -0,0 +1,143 @@
+package blockfetcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/eth-go"
+	"github.com/streamingfast/eth-go/rpc"
+)
+
+// FinalityProvider gives the LIB of the blocks produced from RPC
+type FinalityProvider interface {
+	LIBNum(ctx context.Context, client *rpc.Client, blockNum uint64) (uint64, error)
+}
+
+// FixedDepthFinality considers final the blocks `depth` blocks behind the block
+type FixedDepthFinality struct {
+	depth uint64
+}
+
+func NewFixedDepthFinality(depth uint64) *FixedDepthFinality {
+	return &FixedDepthFinality{depth: depth}
+}
+
+func (f *FixedDepthFinality) LIBNum(_ context.Context, _ *rpc.Client, blockNum uint64) (uint64, error) {
+	if blockNum <= bstream.GetProtocolFirstStreamableBlock+f.depth {
+		return bstream.GetProtocolFirstStreamableBlock, nil
+	}
+
+	return blockNum - f.depth, nil
+}
+
+// BlockTagFinality uses the number of the block having the tag (`finalized` or `safe`) on the endpoint
+type BlockTagFinality struct {
+	tag string
+	lib *refreshedLIB
+}
+
+func NewBlockTagFinality(tag string) *BlockTagFinality {
+	return &BlockTagFinality{tag: tag, lib: &refreshedLIB{}}
+}
+
+func (f *BlockTagFinality) LIBNum(ctx context.Context, client *rpc.Client, blockNum uint64) (uint64, error) {
+	return f.lib.get(blockNum, func() (uint64, error) {
+		return FetchBlockTagNum(ctx, client, f.tag)
+	})
+}
+
+// OptimismSyncStatusFinality uses the L2 head (`finalized_l2` or `safe_l2`) of the `optimism_syncStatus` of
+// an op-node, which is not exposed by the execution client endpoint the blocks are fetched from
+type OptimismSyncStatusFinality struct {
+	rollupClient *rpc.Client
+	head         string
+	lib          *refreshedLIB
+}
+
+func NewOptimismSyncStatusFinality(rollupClient *rpc.Client, head string) *OptimismSyncStatusFinality {
+	return &OptimismSyncStatusFinality{rollupClient: rollupClient, head: head, lib: &refreshedLIB{}}
+}
+
+func (f *OptimismSyncStatusFinality) LIBNum(ctx context.Context, _ *rpc.Client, blockNum uint64) (uint64, error) {
+	return f.lib.get(blockNum, func() (uint64, error) {
+		resp, err := f.rollupClient.DoRequest(ctx, "optimism_syncStatus", []interface{}{})
+		if err != nil {
+			return 0, fmt.Errorf("unable to perform optimism_syncStatus request: %w", err)
+		}
+
+		var status map[string]*struct {
+			Number uint64 `json:"number"`
+		}
+		if err := json.Unmarshal([]byte(resp), &status); err != nil {
+			return 0, fmt.Errorf("unable to decode sync status from JSON: %w", err)
+		}
+		if status[f.head] == nil {
+			return 0, fmt.Errorf("no %q head in sync status", f.head)
+		}
+
+		return status[f.head].Number, nil
+	})
+}
+
+// libRefreshInterval is the minimum delay between two fetches of the LIB from the endpoints
+const libRefreshInterval = 5 * time.Second
+
+// refreshedLIB caches the LIB fetched from an endpoint for libRefreshInterval
+type refreshedLIB struct {
+	libNum    uint64
+	fetchedAt time.Time
+	lock      sync.Mutex
+}
+
+func (l *refreshedLIB) get(blockNum uint64, fetch func() (uint64, error)) (uint64, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if time.Since(l.fetchedAt) > libRefreshInterval {
+		libNum, err := fetch()
+		if err != nil {
+			return 0, err
+		}
+
+		// load-balanced endpoints may not agree, never move the LIB backward
+		l.libNum = max(l.libNum, libNum)
+		l.fetchedAt = time.Now()
+	}
+
+	return capLIBNum(l.libNum, blockNum), nil
+}
+
+// FetchBlockTagNum returns the number of the block having this tag (`latest`, `safe`, `finalized`...)
+func FetchBlockTagNum(ctx context.Context, client *rpc.Client, tag string) (uint64, error) {
+	resp, err := client.DoRequest(ctx, "eth_getBlockByNumber", []interface{}{tag, false})
+	if err != nil {
+		return 0, fmt.Errorf("unable to perform eth_getBlockByNumber request for %q block: %w", tag, err)
+	}
+
+	var header *struct {
+		Number eth.Uint64 `json:"number"`
+	}
+	if err := json.Unmarshal([]byte(resp), &header); err != nil {
+		return 0, fmt.Errorf("unable to decode %q block from JSON: %w", tag, err)
+	}
+	if header == nil {
+		return 0, fmt.Errorf("no %q block on endpoint", tag)
+	}
+
+	return uint64(header.Number), nil
+}
+
+// capLIBNum keeps the LIB below the block, at or above the first streamable block
+func capLIBNum(libNum uint64, blockNum uint64) uint64 {
+	if libNum >= blockNum && blockNum > 0 {
+		libNum = blockNum - 1
+	}
+	if libNum < bstream.GetProtocolFirstStreamableBlock {
+		return bstream.GetProtocolFirstStreamableBlock
+	}
+	return libNum
+}
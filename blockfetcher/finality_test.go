@@ -0,0 +1,76 @@
+package blockfetcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/streamingfast/eth-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixedDepthFinality(t *testing.T) {
+	finality := NewFixedDepthFinality(200)
+
+	libNum, err := finality.LIBNum(context.Background(), nil, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(800), libNum)
+
+	libNum, err = finality.LIBNum(context.Background(), nil, 150)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), libNum)
+}
+
+func TestEndpointFinality(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		var request testRequest
+		json.Unmarshal(body, &request)
+
+		switch request.Method {
+		case "eth_getBlockByNumber":
+			var tag string
+			json.Unmarshal(request.Params[0], &tag)
+			number := map[string]string{"finalized": "0x3e8", "safe": "0x44c"}[tag]
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{"number":"%s"}}`, request.ID, number)
+		case "optimism_syncStatus":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{"unsafe_l2":{"number":1300},"safe_l2":{"number":1200},"finalized_l2":{"number":1050}}}`, request.ID)
+		}
+	}))
+	defer server.Close()
+	client := rpc.NewClient(server.URL)
+
+	tests := []struct {
+		name     string
+		finality FinalityProvider
+		expect   uint64
+	}{
+		{"finalized tag", NewBlockTagFinality("finalized"), 1000},
+		{"safe tag", NewBlockTagFinality("safe"), 1100},
+		{"optimism finalized", NewOptimismSyncStatusFinality(client, "finalized_l2"), 1050},
+		{"optimism safe", NewOptimismSyncStatusFinality(client, "safe_l2"), 1200},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			libNum, err := test.finality.LIBNum(context.Background(), client, 1500)
+			require.NoError(t, err)
+			assert.Equal(t, test.expect, libNum)
+
+			libNum, err = test.finality.LIBNum(context.Background(), client, 900)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(899), libNum, "LIB should stay below the block")
+		})
+	}
+}
+
+func TestCapLIBNum(t *testing.T) {
+	assert.Equal(t, uint64(90), capLIBNum(90, 100))
+	assert.Equal(t, uint64(99), capLIBNum(120, 100), "LIB should stay below the block")
+}
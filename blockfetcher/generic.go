@@ -0,0 +1,31 @@
+package blockfetcher
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/eth-go/rpc"
+	"github.com/streamingfast/firehose-ethereum/block"
+)
+
+// GenericBlockFetcher fetches the blocks of EVM chains without chain-specific fields
+type GenericBlockFetcher struct {
+	*BlockFetcher
+}
+
+func NewGenericBlockFetcher(intervalBetweenFetch time.Duration, latestBlockRetryInterval time.Duration, logger *zap.Logger) *GenericBlockFetcher {
+	fetcher := NewBlockFetcher(intervalBetweenFetch, latestBlockRetryInterval, block.RpcToEthBlock, logger)
+	return &GenericBlockFetcher{
+		BlockFetcher: fetcher,
+	}
+}
+
+func (f *GenericBlockFetcher) PollingInterval() time.Duration { return 5 * time.Second }
+
+func (f *GenericBlockFetcher) Fetch(ctx context.Context, rpcClient *rpc.Client, blockNum uint64) (b *pbbstream.Block, skipped bool, err error) {
+	blk, err := f.BlockFetcher.Fetch(ctx, rpcClient, blockNum)
+	return blk, false, err
+}
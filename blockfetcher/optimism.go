@@ -11,23 +11,21 @@ import (
 	"github.com/streamingfast/firehose-ethereum/block"
 )
 
+// OptimismBlockFetcher fetches the blocks of OP stack chains, with the fields of their deposit transactions
+// and the L1 fee and deposit fields of their receipts
 type OptimismBlockFetcher struct {
-	fetcher *BlockFetcher
-}
-
-func (f *OptimismBlockFetcher) IsBlockAvailable(requested uint64) bool {
-	return f.fetcher.IsBlockAvailable(requested)
+	*BlockFetcher
 }
 
 func (f *OptimismBlockFetcher) Fetch(ctx context.Context, rpcClient *rpc.Client, blockNum uint64) (b *pbbstream.Block, skipped bool, err error) {
-	blk, err := f.fetcher.Fetch(ctx, rpcClient, blockNum)
+	blk, err := f.BlockFetcher.Fetch(ctx, rpcClient, blockNum)
 	return blk, false, err
 }
 
 func NewOptimismBlockFetcher(intervalBetweenFetch time.Duration, latestBlockRetryInterval time.Duration, logger *zap.Logger) *OptimismBlockFetcher {
-	fetcher := NewBlockFetcher(intervalBetweenFetch, latestBlockRetryInterval, block.RpcToEthBlock, logger)
+	fetcher := NewBlockFetcher(intervalBetweenFetch, latestBlockRetryInterval, block.OptimismRpcToEthBlock, logger)
 	return &OptimismBlockFetcher{
-		fetcher: fetcher,
+		BlockFetcher: fetcher,
 	}
 }
 
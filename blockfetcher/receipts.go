@@ -0,0 +1,328 @@
+package blockfetcher
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/abourget/llerrgroup"
+	"github.com/streamingfast/derr"
+	"github.com/streamingfast/eth-go"
+	"github.com/streamingfast/eth-go/rpc"
+	"github.com/streamingfast/firehose-ethereum/block"
+	"go.uber.org/zap"
+)
+
+type ReceiptsStrategy string
+
+const (
+	// ReceiptsStrategyAuto uses the first strategy supported by the endpoint, in the order below
+	ReceiptsStrategyAuto ReceiptsStrategy = "auto"
+	// ReceiptsStrategyBlockReceipts fetches all the receipts of the block with one `eth_getBlockReceipts` call
+	ReceiptsStrategyBlockReceipts ReceiptsStrategy = "block-receipts"
+	// ReceiptsStrategyBatch fetches the receipts with JSON-RPC batches of `eth_getTransactionReceipt` calls
+	ReceiptsStrategyBatch ReceiptsStrategy = "batch"
+	// ReceiptsStrategyPerTransaction fetches the receipts with one `eth_getTransactionReceipt` call per transaction
+	ReceiptsStrategyPerTransaction ReceiptsStrategy = "per-transaction"
+)
+
+// errBatchesRejected is returned when the endpoint doesn't answer a batch with one response per request while it
+// answers its requests sent alone, which is how most endpoints not supporting batches answer (with a single error
+// response)
+var errBatchesRejected = errors.New("endpoint rejected the batch request")
+
+// unsupportedMethodErrorCodes are the JSON-RPC error codes telling that the endpoint doesn't support a method,
+// "method not found" (JSON-RPC 2.0) and "method not supported" (EIP-1474)
+var unsupportedMethodErrorCodes = []rpc.ErrorCode{-32601, -32004}
+
+var autoReceiptsStrategies = []ReceiptsStrategy{ReceiptsStrategyBlockReceipts, ReceiptsStrategyBatch, ReceiptsStrategyPerTransaction}
+
+func ParseReceiptsStrategy(in string) (ReceiptsStrategy, error) {
+	switch strategy := ReceiptsStrategy(in); strategy {
+	case ReceiptsStrategyAuto, ReceiptsStrategyBlockReceipts, ReceiptsStrategyBatch, ReceiptsStrategyPerTransaction:
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("invalid receipts strategy %q, valid values are %q, %q, %q and %q", in, ReceiptsStrategyAuto, ReceiptsStrategyBlockReceipts, ReceiptsStrategyBatch, ReceiptsStrategyPerTransaction)
+	}
+}
+
+// ReceiptsFetcher fetches the receipts of the blocks with its strategy. With ReceiptsStrategyAuto, the
+// strategies are tried in order while the endpoint rejects them as unsupported, the first one that works
+// is then kept for the endpoint. Other errors are possibly transient and returned so that the fetch is
+// retried with the same strategy.
+type ReceiptsFetcher struct {
+	strategy    ReceiptsStrategy
+	batchSize   int
+	concurrency int
+	logger      *zap.Logger
+
+	endpointStrategies map[string]ReceiptsStrategy
+	lock               sync.Mutex
+}
+
+func NewReceiptsFetcher(strategy ReceiptsStrategy, batchSize int, concurrency int, logger *zap.Logger) *ReceiptsFetcher {
+	return &ReceiptsFetcher{
+		strategy:           strategy,
+		batchSize:          batchSize,
+		concurrency:        concurrency,
+		logger:             logger,
+		endpointStrategies: make(map[string]ReceiptsStrategy),
+	}
+}
+
+func (f *ReceiptsFetcher) Fetch(ctx context.Context, client *rpc.Client, rpcBlock *rpc.Block) (map[string]*block.RpcReceipt, error) {
+	if len(rpcBlock.Transactions.Transactions) == 0 {
+		return map[string]*block.RpcReceipt{}, nil
+	}
+
+	strategy := f.endpointStrategy(client)
+	if strategy != ReceiptsStrategyAuto {
+		return f.fetch(ctx, client, rpcBlock, strategy)
+	}
+
+	for _, candidate := range autoReceiptsStrategies {
+		out, err := f.fetch(ctx, client, rpcBlock, candidate)
+		if err == nil {
+			f.setEndpointStrategy(client, candidate)
+			return out, nil
+		}
+		if !isUnsupportedStrategyError(err) {
+			return nil, fmt.Errorf("fetching receipts with strategy %q: %w", candidate, err)
+		}
+
+		f.logger.Debug("receipts strategy not supported by endpoint, falling back to the next one", zap.Stringer("endpoint", client), zap.String("strategy", string(candidate)), zap.Error(err))
+	}
+	return nil, fmt.Errorf("no receipts strategy supported by endpoint %s", client)
+}
+
+func isUnsupportedStrategyError(err error) bool {
+	if errors.Is(err, errBatchesRejected) {
+		return true
+	}
+
+	var rpcErr *rpc.ErrResponse
+	return errors.As(err, &rpcErr) && slices.Contains(unsupportedMethodErrorCodes, rpcErr.Code)
+}
+
+func (f *ReceiptsFetcher) fetch(ctx context.Context, client *rpc.Client, rpcBlock *rpc.Block, strategy ReceiptsStrategy) (map[string]*block.RpcReceipt, error) {
+	switch strategy {
+	case ReceiptsStrategyBlockReceipts:
+		return fetchBlockReceipts(ctx, client, rpcBlock)
+	case ReceiptsStrategyBatch:
+		return fetchBatchedReceipts(ctx, client, rpcBlock, f.batchSize, f.concurrency)
+	case ReceiptsStrategyPerTransaction:
+		return fetchReceiptsPerTransaction(ctx, client, rpcBlock, f.concurrency)
+	default:
+		return nil, fmt.Errorf("unsupported receipts strategy %q", strategy)
+	}
+}
+
+func (f *ReceiptsFetcher) endpointStrategy(client *rpc.Client) ReceiptsStrategy {
+	if f.strategy != ReceiptsStrategyAuto {
+		return f.strategy
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if strategy, found := f.endpointStrategies[client.String()]; found {
+		return strategy
+	}
+	return ReceiptsStrategyAuto
+}
+
+func (f *ReceiptsFetcher) setEndpointStrategy(client *rpc.Client, strategy ReceiptsStrategy) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.endpointStrategies[client.String()] = strategy
+	f.logger.Info("selected receipts strategy for endpoint", zap.Stringer("endpoint", client), zap.String("strategy", string(strategy)))
+}
+
+func fetchBlockReceipts(ctx context.Context, client *rpc.Client, rpcBlock *rpc.Block) (map[string]*block.RpcReceipt, error) {
+	resp, err := client.DoRequest(ctx, "eth_getBlockReceipts", []interface{}{rpcBlock.Hash})
+	if err != nil {
+		return nil, fmt.Errorf("unable to perform eth_getBlockReceipts request: %w", err)
+	}
+	if resp == "" {
+		return nil, fmt.Errorf("no receipts returned for block %s", rpcBlock.Hash.Pretty())
+	}
+
+	var receipts []*block.RpcReceipt
+	if err := json.Unmarshal([]byte(resp), &receipts); err != nil {
+		return nil, fmt.Errorf("unable to decode receipts from JSON: %w", err)
+	}
+
+	out := make(map[string]*block.RpcReceipt, len(receipts))
+	for _, receipt := range receipts {
+		if receipt != nil {
+			out[receipt.TransactionHash.Pretty()] = receipt
+		}
+	}
+	for _, tx := range rpcBlock.Transactions.Transactions {
+		if out[tx.Hash.Pretty()] == nil {
+			return nil, fmt.Errorf("missing receipt for tx %s in block receipts", tx.Hash.Pretty())
+		}
+	}
+	return out, nil
+}
+
+func fetchBatchedReceipts(ctx context.Context, client *rpc.Client, rpcBlock *rpc.Block, batchSize int, concurrency int) (out map[string]*block.RpcReceipt, err error) {
+	out = make(map[string]*block.RpcReceipt)
+	lock := sync.Mutex{}
+
+	transactions := rpcBlock.Transactions.Transactions
+	eg := llerrgroup.New(concurrency)
+	for start := 0; start < len(transactions); start += batchSize {
+		if eg.Stop() {
+			continue // short-circuit the loop if we got an error
+		}
+
+		hashes := make([]eth.Hash, 0, batchSize)
+		for _, tx := range transactions[start:min(start+batchSize, len(transactions))] {
+			hashes = append(hashes, tx.Hash)
+		}
+
+		eg.Go(func() error {
+			receipts := make([]*block.RpcReceipt, len(hashes))
+			err := derr.RetryContext(ctx, 10, func(ctx context.Context) error {
+				requests := make([]*rpc.RPCRequest, len(hashes))
+				for i, hash := range hashes {
+					requests[i] = &rpc.RPCRequest{Method: "eth_getTransactionReceipt", Params: []interface{}{hash}}
+				}
+
+				responses, err := doBatchRequests(ctx, client, requests)
+				if err != nil {
+					if errors.Is(err, errBatchesRejected) {
+						return derr.NewFatalError(err)
+					}
+					return fmt.Errorf("unable to perform batch of %d eth_getTransactionReceipt requests: %w", len(requests), err)
+				}
+
+				for i, resp := range responses {
+					if resp.Err != nil {
+						err := fmt.Errorf("fetching receipt for tx %s: %w", hashes[i].Pretty(), resp.Err)
+						if isUnsupportedStrategyError(resp.Err) {
+							return derr.NewFatalError(err)
+						}
+						return err
+					}
+					if resp.Content == "" {
+						return fmt.Errorf("fetching receipt for tx %s: receipt is nil", hashes[i].Pretty())
+					}
+
+					if err := json.Unmarshal([]byte(resp.Content), &receipts[i]); err != nil {
+						return fmt.Errorf("decoding receipt for tx %s: %w", hashes[i].Pretty(), err)
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			lock.Lock()
+			for i, receipt := range receipts {
+				out[hashes[i].Pretty()] = receipt
+			}
+			lock.Unlock()
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// doBatchRequests sends the requests as a single JSON-RPC batch with rpc.Client.DoRequests. Endpoints not
+// supporting batches answer them with a single error response, which DoRequests rejects as not having one
+// result per request: when the batch fails while its first request sent alone succeeds, errBatchesRejected
+// is returned.
+func doBatchRequests(ctx context.Context, client *rpc.Client, requests []*rpc.RPCRequest) ([]*rpc.RPCResponse, error) {
+	responses, err := client.DoRequests(ctx, requests)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		if _, singleErr := client.DoRequest(ctx, requests[0].Method, requests[0].Params); singleErr != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %s", errBatchesRejected, err)
+	}
+
+	// DoRequests numbered the requests from 1 and sorted the responses by id
+	for i, response := range responses {
+		if response == nil || response.ID != requests[i].ID {
+			return nil, fmt.Errorf("%w: response #%d doesn't match request id %d", errBatchesRejected, i, requests[i].ID)
+		}
+	}
+	return responses, nil
+}
+
+// FetchReceipts fetches the receipts of the block with one `eth_getTransactionReceipt` call per transaction
+func FetchReceipts(ctx context.Context, rpcBlock *rpc.Block, client *rpc.Client) (out map[string]*block.RpcReceipt, err error) {
+	return fetchReceiptsPerTransaction(ctx, client, rpcBlock, 10)
+}
+
+func fetchReceiptsPerTransaction(ctx context.Context, client *rpc.Client, rpcBlock *rpc.Block, concurrency int) (out map[string]*block.RpcReceipt, err error) {
+	out = make(map[string]*block.RpcReceipt)
+	lock := sync.Mutex{}
+
+	eg := llerrgroup.New(concurrency)
+	for _, tx := range rpcBlock.Transactions.Transactions {
+		if eg.Stop() {
+			continue // short-circuit the loop if we got an error
+		}
+		hash := tx.Hash
+		eg.Go(func() error {
+			var receipt *block.RpcReceipt
+			err := derr.RetryContext(ctx, 10, func(ctx context.Context) error {
+				r, err := fetchReceipt(ctx, client, hash)
+				if err != nil {
+					return err
+				}
+				if r == nil {
+					return fmt.Errorf("receipt is nil")
+				}
+
+				receipt = r
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("fetching receipt for tx %s: %w", hash.Pretty(), err)
+			}
+
+			lock.Lock()
+			out[hash.Pretty()] = receipt
+			lock.Unlock()
+			return err
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+func fetchReceipt(ctx context.Context, client *rpc.Client, hash eth.Hash) (out *block.RpcReceipt, err error) {
+	resp, err := client.DoRequest(ctx, "eth_getTransactionReceipt", []interface{}{hash})
+	if err != nil {
+		return nil, fmt.Errorf("unable to perform eth_getTransactionReceipt request: %w", err)
+	}
+
+	if resp == "" {
+		return nil, nil
+	}
+
+	if err := json.Unmarshal([]byte(resp), &out); err != nil {
+		return nil, fmt.Errorf("unable to decode receipt from JSON: %w", err)
+	}
+	return out, nil
+}
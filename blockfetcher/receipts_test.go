@@ -0,0 +1,208 @@
+package blockfetcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	"github.com/streamingfast/eth-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testReceiptsEndpoint struct {
+	blockReceipts bool
+	batches       bool
+	// truncatedBatches answers the batches with one response less than there are requests
+	truncatedBatches bool
+	// blockReceiptsErrors is the number of eth_getBlockReceipts calls answered with an internal error
+	blockReceiptsErrors int
+	// unavailableRequests is the number of HTTP requests answered with a 503 status
+	unavailableRequests int
+
+	calls map[string]int
+	lock  sync.Mutex
+}
+
+func (e *testReceiptsEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	e.lock.Lock()
+	unavailable := e.unavailableRequests > 0
+	if unavailable {
+		e.unavailableRequests--
+	}
+	e.lock.Unlock()
+	if unavailable {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	var requests []testRequest
+	batch := body[0] == '['
+	if batch {
+		if !e.batches {
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"batch requests are not supported"}}`)
+			return
+		}
+		json.Unmarshal(body, &requests)
+	} else {
+		var request testRequest
+		json.Unmarshal(body, &request)
+		requests = []testRequest{request}
+	}
+
+	responses := make([]string, len(requests))
+	for i, request := range requests {
+		e.lock.Lock()
+		e.calls[request.Method]++
+		e.lock.Unlock()
+
+		switch request.Method {
+		case "eth_getBlockReceipts":
+			if e.blockReceiptsErrors > 0 {
+				e.blockReceiptsErrors--
+				responses[i] = fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"error":{"code":-32000,"message":"header not found"}}`, request.ID)
+				continue
+			}
+			if !e.blockReceipts {
+				responses[i] = fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"error":{"code":-32601,"message":"the method eth_getBlockReceipts does not exist/is not available"}}`, request.ID)
+				continue
+			}
+			responses[i] = fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":[%s,%s]}`, request.ID, testReceipt("0x01"), testReceipt("0x02"))
+		case "eth_getTransactionReceipt":
+			var hash string
+			json.Unmarshal(request.Params[0], &hash)
+			responses[i] = fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":%s}`, request.ID, testReceipt(hash))
+		}
+	}
+
+	if batch {
+		if e.truncatedBatches {
+			responses = responses[:len(responses)-1]
+		}
+		fmt.Fprint(w, "[")
+		for i, response := range responses {
+			if i > 0 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprint(w, response)
+		}
+		fmt.Fprint(w, "]")
+		return
+	}
+	fmt.Fprint(w, responses[0])
+}
+
+func testReceipt(hash string) string {
+	return fmt.Sprintf(`{"transactionHash":"%s","gasUsed":"0x5208","status":"0x1","logs":[]}`, eth.MustNewHash(hash).Pretty())
+}
+
+func TestReceiptsFetcher_Auto(t *testing.T) {
+	block := &rpc.Block{
+		Hash: eth.MustNewHash("0xaa"),
+		Transactions: &rpc.BlockTransactions{Transactions: []rpc.Transaction{
+			{Hash: eth.MustNewHash("0x01")},
+			{Hash: eth.MustNewHash("0x02")},
+		}},
+	}
+
+	tests := []struct {
+		name             string
+		blockReceipts    bool
+		batches          bool
+		truncatedBatches bool
+		expectCalls      map[string]int
+	}{
+		{"block receipts", true, true, false, map[string]int{"eth_getBlockReceipts": 2}},
+		{"batches", false, true, false, map[string]int{"eth_getBlockReceipts": 1, "eth_getTransactionReceipt": 4}},
+		{"per transaction", false, false, false, map[string]int{"eth_getBlockReceipts": 1, "eth_getTransactionReceipt": 5}},
+		{"truncated batches", false, true, true, map[string]int{"eth_getBlockReceipts": 1, "eth_getTransactionReceipt": 7}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			endpoint := &testReceiptsEndpoint{blockReceipts: test.blockReceipts, batches: test.batches, truncatedBatches: test.truncatedBatches, calls: map[string]int{}}
+			server := httptest.NewServer(endpoint)
+			defer server.Close()
+
+			client := rpc.NewClient(server.URL)
+			fetcher := NewReceiptsFetcher(ReceiptsStrategyAuto, 2, 2, zap.NewNop())
+
+			for i := 0; i < 2; i++ {
+				receipts, err := fetcher.Fetch(context.Background(), client, block)
+				require.NoError(t, err)
+				require.Len(t, receipts, 2)
+				assert.Equal(t, uint64(0x5208), uint64(receipts[eth.MustNewHash("0x02").Pretty()].GasUsed))
+			}
+
+			assert.Equal(t, test.expectCalls, endpoint.calls, "strategy should be selected on first fetch then reused")
+		})
+	}
+}
+
+func TestReceiptsFetcher_AutoTransientError(t *testing.T) {
+	block := &rpc.Block{
+		Hash:         eth.MustNewHash("0xaa"),
+		Transactions: &rpc.BlockTransactions{Transactions: []rpc.Transaction{{Hash: eth.MustNewHash("0x01")}}},
+	}
+
+	endpoint := &testReceiptsEndpoint{blockReceipts: true, batches: true, blockReceiptsErrors: 1, calls: map[string]int{}}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+
+	client := rpc.NewClient(server.URL)
+	fetcher := NewReceiptsFetcher(ReceiptsStrategyAuto, 2, 2, zap.NewNop())
+
+	_, err := fetcher.Fetch(context.Background(), client, block)
+	require.Error(t, err)
+	assert.Equal(t, ReceiptsStrategyAuto, fetcher.endpointStrategy(client), "transient error should not select a strategy")
+
+	for i := 0; i < 2; i++ {
+		receipts, err := fetcher.Fetch(context.Background(), client, block)
+		require.NoError(t, err)
+		require.Len(t, receipts, 2)
+	}
+
+	assert.Equal(t, ReceiptsStrategyBlockReceipts, fetcher.endpointStrategy(client))
+	assert.Equal(t, map[string]int{"eth_getBlockReceipts": 3}, endpoint.calls)
+}
+
+func TestReceiptsFetcher_BatchTransientError(t *testing.T) {
+	block := &rpc.Block{
+		Hash: eth.MustNewHash("0xaa"),
+		Transactions: &rpc.BlockTransactions{Transactions: []rpc.Transaction{
+			{Hash: eth.MustNewHash("0x01")},
+			{Hash: eth.MustNewHash("0x02")},
+		}},
+	}
+
+	// the batch and the request sent alone to check whether batches are supported both fail
+	endpoint := &testReceiptsEndpoint{batches: true, unavailableRequests: 2, calls: map[string]int{}}
+	server := httptest.NewServer(endpoint)
+	defer server.Close()
+
+	client := rpc.NewClient(server.URL)
+	fetcher := NewReceiptsFetcher(ReceiptsStrategyBatch, 2, 2, zap.NewNop())
+
+	receipts, err := fetcher.Fetch(context.Background(), client, block)
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	assert.Equal(t, map[string]int{"eth_getTransactionReceipt": 2}, endpoint.calls, "batch should be retried")
+}
+
+func TestParseReceiptsStrategy(t *testing.T) {
+	strategy, err := ParseReceiptsStrategy("batch")
+	require.NoError(t, err)
+	assert.Equal(t, ReceiptsStrategyBatch, strategy)
+
+	_, err = ParseReceiptsStrategy("bulk")
+	require.Error(t, err)
+}
@@ -0,0 +1,135 @@
+package blockfetcher
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/eth-go"
+	"github.com/streamingfast/firehose-core/blockpoller"
+	"go.uber.org/zap"
+)
+
+// maxTrackedBlocks bounds the number of blocks kept by the chainTracker on chains whose LIB lags far behind
+const maxTrackedBlocks = 10_000
+
+// chainTracker records the hash of the blocks fired by the poller and the parent hash of the last block fetched
+// at each height. When a fetched block doesn't link to the fired block preceding it, the chain reorganized: the
+// poller, not finding the parent in its fork database, requests it by number, and the tracker gives its hash so
+// that it's fetched by hash. Each block of the canonical branch fetched this way gives the hash of the next one
+// requested, walking back to the fork point, where the poller links the branch and fires it.
+//
+// Fetching the canonical branch by hash matters when the node answers by number from its previous view of the
+// chain, like load balanced endpoints whose nodes haven't all processed the reorg yet.
+type chainTracker struct {
+	fired   map[uint64]eth.Hash
+	parents map[uint64]eth.Hash
+	libNum  uint64
+
+	lock   sync.Mutex
+	logger *zap.Logger
+}
+
+func newChainTracker(logger *zap.Logger) *chainTracker {
+	return &chainTracker{
+		fired:   make(map[uint64]eth.Hash),
+		parents: make(map[uint64]eth.Hash),
+		logger:  logger,
+	}
+}
+
+// canonicalHash returns the hash of the block to fetch at this height when the block fetched above it doesn't
+// link to the fired one
+func (t *chainTracker) canonicalHash(blockNum uint64) eth.Hash {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	parentHash, found := t.parents[blockNum+1]
+	if !found {
+		return nil
+	}
+	firedHash, found := t.fired[blockNum]
+	if !found || bytes.Equal(firedHash, parentHash) {
+		return nil
+	}
+	return parentHash
+}
+
+// checkContinuity records the parent of the fetched block, logging the reorg when it's not the fired block
+// preceding it
+func (t *chainTracker) checkContinuity(blockNum uint64, parentHash eth.Hash) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if blockNum <= t.libNum {
+		return
+	}
+	t.parents[blockNum] = parentHash
+
+	if firedParent, found := t.fired[blockNum-1]; found && !bytes.Equal(firedParent, parentHash) {
+		t.logger.Info("reorg detected, walking back the canonical branch by hash",
+			zap.Uint64("block_num", blockNum),
+			zap.Stringer("parent_hash", parentHash),
+			zap.Stringer("fired_parent_hash", firedParent),
+		)
+	}
+}
+
+// fire records the fired block, forgetting the blocks of the branch it replaces and the ones below its LIB
+func (t *chainTracker) fire(blockNum uint64, hash eth.Hash, libNum uint64) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.fired[blockNum] = hash
+	delete(t.parents, blockNum)
+	for num := blockNum + 1; ; num++ {
+		if _, found := t.fired[num]; !found {
+			break
+		}
+		delete(t.fired, num)
+	}
+
+	if blockNum > maxTrackedBlocks && libNum < blockNum-maxTrackedBlocks {
+		libNum = blockNum - maxTrackedBlocks
+	}
+	if libNum <= t.libNum {
+		return
+	}
+	t.libNum = libNum
+
+	for num := range t.fired {
+		if num < libNum {
+			delete(t.fired, num)
+		}
+	}
+	for num := range t.parents {
+		if num <= libNum {
+			delete(t.parents, num)
+		}
+	}
+}
+
+// TrackFiredBlocks wraps the block handler of the poller so that the fetcher knows the fired blocks, which
+// it needs to fetch the canonical branch by hash when the chain reorganizes
+func (f *BlockFetcher) TrackFiredBlocks(handler blockpoller.BlockHandler) blockpoller.BlockHandler {
+	return &firedBlocksHandler{BlockHandler: handler, tracker: f.tracker}
+}
+
+type firedBlocksHandler struct {
+	blockpoller.BlockHandler
+	tracker *chainTracker
+}
+
+func (h *firedBlocksHandler) Handle(blk *pbbstream.Block) error {
+	if err := h.BlockHandler.Handle(blk); err != nil {
+		return err
+	}
+
+	hash, err := eth.NewHash(blk.Id)
+	if err != nil {
+		return fmt.Errorf("invalid block %d id %q: %w", blk.Number, blk.Id, err)
+	}
+	h.tracker.fire(blk.Number, hash, blk.LibNum)
+	return nil
+}
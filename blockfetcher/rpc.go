@@ -2,29 +2,33 @@ package blockfetcher
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"sync"
 	"time"
 
-	"github.com/abourget/llerrgroup"
-	"github.com/streamingfast/bstream"
 	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
-	"github.com/streamingfast/derr"
+	"github.com/streamingfast/eth-go"
 	"github.com/streamingfast/eth-go/rpc"
+	"github.com/streamingfast/firehose-ethereum/block"
 	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-type ToEthBlock func(in *rpc.Block, receipts map[string]*rpc.TransactionReceipt, logger *zap.Logger) (*pbeth.Block, map[string]bool)
+type ToEthBlock func(in *block.RpcBlock, receipts map[string]*block.RpcReceipt, logger *zap.Logger) (*pbeth.Block, map[string]bool)
 
 type BlockFetcher struct {
 	latest                   uint64
 	latestBlockRetryInterval time.Duration
 	fetchInterval            time.Duration
 	toEthBlock               ToEthBlock
+	callTraces               bool
+	stateDiffs               bool
+	receiptsFetcher          *ReceiptsFetcher
+	tracker                  *chainTracker
 	lastFetchAt              time.Time
+	finality                 FinalityProvider
 	logger                   *zap.Logger
 }
 
@@ -33,15 +37,38 @@ func NewBlockFetcher(intervalBetweenFetch, latestBlockRetryInterval time.Duratio
 		latestBlockRetryInterval: latestBlockRetryInterval,
 		toEthBlock:               toEthBlock,
 		fetchInterval:            intervalBetweenFetch,
+		receiptsFetcher:          NewReceiptsFetcher(ReceiptsStrategyAuto, 100, 10, logger),
+		tracker:                  newChainTracker(logger),
+		finality:                 NewFixedDepthFinality(200),
 		logger:                   logger,
 	}
 }
 
+// SetFinalityProvider replaces the default finality, which considers final the blocks 200 blocks behind
+func (f *BlockFetcher) SetFinalityProvider(finality FinalityProvider) {
+	f.finality = finality
+}
+
+// SetReceiptsFetcher replaces the default receipts fetcher, which uses ReceiptsStrategyAuto with batches
+// of 100 requests and a concurrency of 10
+func (f *BlockFetcher) SetReceiptsFetcher(receiptsFetcher *ReceiptsFetcher) {
+	f.receiptsFetcher = receiptsFetcher
+}
+
+// EnableCallTraces makes the fetcher produce DETAILLEVEL_TRACE blocks, with the calls of each transaction
+// obtained from `debug_traceBlockByHash` and, if withStateDiffs is true, their state changes
+func (f *BlockFetcher) EnableCallTraces(withStateDiffs bool) {
+	f.callTraces = true
+	f.stateDiffs = withStateDiffs
+}
+
 func (f *BlockFetcher) IsBlockAvailable(blockNum uint64) bool {
 	return blockNum <= f.latest
 }
 
-func (f *BlockFetcher) Fetch(ctx context.Context, rpcClient *rpc.Client, blockNum uint64) (block *pbbstream.Block, err error) {
+// Fetch fetches the block by number, or by hash when it's part of the canonical branch of a reorg walked back
+// by the poller, see chainTracker
+func (f *BlockFetcher) Fetch(ctx context.Context, rpcClient *rpc.Client, blockNum uint64) (out *pbbstream.Block, err error) {
 	f.logger.Debug("fetching block", zap.Uint64("block_num", blockNum))
 	for f.latest < blockNum {
 		f.latest, err = rpcClient.LatestBlockNum(ctx)
@@ -63,12 +90,13 @@ func (f *BlockFetcher) Fetch(ctx context.Context, rpcClient *rpc.Client, blockNu
 		time.Sleep(f.fetchInterval - sinceLastFetch)
 	}
 
-	rpcBlock, err := rpcClient.GetBlockByNumber(ctx, rpc.BlockNumber(blockNum), rpc.WithGetBlockFullTransaction())
+	rpcBlock, err := f.fetchBlock(ctx, rpcClient, blockNum)
 	if err != nil {
 		return nil, fmt.Errorf("fetching block %d: %w", blockNum, err)
 	}
+	f.tracker.checkContinuity(uint64(rpcBlock.Number), rpcBlock.ParentHash)
 
-	receipts, err := FetchReceipts(ctx, rpcBlock, rpcClient)
+	receipts, err := f.receiptsFetcher.Fetch(ctx, rpcClient, rpcBlock.Block)
 	if err != nil {
 		return nil, fmt.Errorf("fetching receipts for block %d %q: %w", rpcBlock.Number, rpcBlock.Hash.Pretty(), err)
 	}
@@ -82,6 +110,17 @@ func (f *BlockFetcher) Fetch(ctx context.Context, rpcClient *rpc.Client, blockNu
 	}
 
 	ethBlock, _ := f.toEthBlock(rpcBlock, receipts, f.logger)
+	if f.callTraces {
+		if err := f.addCallTraces(ctx, rpcClient, rpcBlock, ethBlock); err != nil {
+			return nil, fmt.Errorf("tracing block %d %q: %w", rpcBlock.Number, rpcBlock.Hash.Pretty(), err)
+		}
+	}
+
+	libNum, err := f.finality.LIBNum(ctx, rpcClient, ethBlock.Number)
+	if err != nil {
+		return nil, fmt.Errorf("resolving LIB of block %d: %w", ethBlock.Number, err)
+	}
+
 	anyBlock, err := anypb.New(ethBlock)
 	if err != nil {
 		return nil, fmt.Errorf("create any block: %w", err)
@@ -92,58 +131,74 @@ func (f *BlockFetcher) Fetch(ctx context.Context, rpcClient *rpc.Client, blockNu
 		Id:        ethBlock.GetFirehoseBlockID(),
 		ParentId:  ethBlock.GetFirehoseBlockParentID(),
 		Timestamp: timestamppb.New(ethBlock.GetFirehoseBlockTime()),
-		LibNum:    ethBlockLIBNum(ethBlock),
+		LibNum:    libNum,
 		ParentNum: ethBlock.GetFirehoseBlockParentNumber(),
 		Payload:   anyBlock,
 	}, nil
 }
 
-func FetchReceipts(ctx context.Context, block *rpc.Block, client *rpc.Client) (out map[string]*rpc.TransactionReceipt, err error) {
-	out = make(map[string]*rpc.TransactionReceipt)
-	lock := sync.Mutex{}
+func (f *BlockFetcher) addCallTraces(ctx context.Context, rpcClient *rpc.Client, rpcBlock *block.RpcBlock, ethBlock *pbeth.Block) error {
+	frames, err := FetchCallTraces(ctx, rpcClient, rpcBlock)
+	if err != nil {
+		return fmt.Errorf("fetching call traces: %w", err)
+	}
 
-	eg := llerrgroup.New(10)
-	for _, tx := range block.Transactions.Transactions {
-		if eg.Stop() {
-			continue // short-circuit the loop if we got an error
+	var stateDiffs []*block.RpcStateDiff
+	if f.stateDiffs {
+		stateDiffs, err = FetchStateDiffs(ctx, rpcClient, rpcBlock)
+		if err != nil {
+			return fmt.Errorf("fetching state diffs: %w", err)
 		}
-		hash := tx.Hash
-		eg.Go(func() error {
-			var receipt *rpc.TransactionReceipt
-			err := derr.RetryContext(ctx, 10, func(ctx context.Context) error {
-				r, err := client.TransactionReceipt(ctx, hash)
-				if err != nil {
-					return err
-				}
-				if r == nil {
-					return fmt.Errorf("receipt is nil")
-				}
-
-				receipt = r
-				return nil
-			})
-			if err != nil {
-				return fmt.Errorf("fetching receipt for tx %s: %w", hash.Pretty(), err)
-			}
-
-			lock.Lock()
-			out[hash.Pretty()] = receipt
-			lock.Unlock()
-			return err
-		})
-	}
-
-	if err := eg.Wait(); err != nil {
+	}
+
+	return block.AddCallTraces(ethBlock, frames, stateDiffs)
+}
+
+func (f *BlockFetcher) fetchBlock(ctx context.Context, rpcClient *rpc.Client, blockNum uint64) (*block.RpcBlock, error) {
+	if hash := f.tracker.canonicalHash(blockNum); hash != nil {
+		rpcBlock, err := FetchBlockByHash(ctx, rpcClient, hash)
+		if err != nil {
+			return nil, err
+		}
+		if rpcBlock != nil {
+			return rpcBlock, nil
+		}
+
+		// the node may have reorganized again, the next block fetched by number gives the new branch
+		f.logger.Warn("canonical block not found, fetching it by number", zap.Uint64("block_num", blockNum), zap.Stringer("hash", hash))
+	}
+
+	return FetchBlock(ctx, rpcClient, blockNum)
+}
+
+// FetchBlock fetches the block with its full transactions, decoding the transaction fields that
+// rpc.Client.GetBlockByNumber drops, like the authorization list of set code transactions
+func FetchBlock(ctx context.Context, client *rpc.Client, blockNum uint64) (*block.RpcBlock, error) {
+	out, err := fetchRpcBlock(ctx, client, "eth_getBlockByNumber", rpc.BlockNumber(blockNum))
+	if err != nil {
 		return nil, err
 	}
+	if out == nil {
+		return nil, fmt.Errorf("block %d not found", blockNum)
+	}
 
-	return
+	return out, nil
 }
 
-func ethBlockLIBNum(b *pbeth.Block) uint64 {
-	if b.Number <= bstream.GetProtocolFirstStreamableBlock+200 {
-		return bstream.GetProtocolFirstStreamableBlock
+// FetchBlockByHash fetches the block with its full transactions like FetchBlock, returning nil if the node
+// doesn't know the block
+func FetchBlockByHash(ctx context.Context, client *rpc.Client, hash eth.Hash) (*block.RpcBlock, error) {
+	return fetchRpcBlock(ctx, client, "eth_getBlockByHash", hash)
+}
+
+func fetchRpcBlock(ctx context.Context, client *rpc.Client, method string, identifier interface{}) (out *block.RpcBlock, err error) {
+	resp, err := client.DoRequest(ctx, method, []interface{}{identifier, true})
+	if err != nil {
+		return nil, fmt.Errorf("unable to perform %s request: %w", method, err)
 	}
 
-	return b.Number - 200
+	if err := json.Unmarshal([]byte(resp), &out); err != nil {
+		return nil, fmt.Errorf("unable to decode block from JSON: %w", err)
+	}
+	return out, nil
 }
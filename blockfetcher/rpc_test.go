@@ -0,0 +1,165 @@
+package blockfetcher
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/eth-go/rpc"
+	"github.com/streamingfast/firehose-core/blockpoller"
+	firecorerpc "github.com/streamingfast/firehose-core/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// testChainEndpoint answers `eth_blockNumber`, `eth_getBlockByNumber` and `eth_getBlockByHash` from a chain
+// whose blocks from forkBlock are replaced by another branch once reorged is set. Blocks below laggingBelow
+// are still answered by number from the old branch after the reorg, like a node that hasn't processed it yet.
+type testChainEndpoint struct {
+	head         uint64
+	forkBlock    uint64
+	laggingBelow uint64
+
+	reorged bool
+	calls   map[string]int
+	lock    sync.Mutex
+}
+
+func testChainBlockHash(branch string, blockNum uint64) string {
+	return fmt.Sprintf("%s%062x", branch, blockNum)
+}
+
+func (e *testChainEndpoint) reorg() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.reorged = true
+}
+
+func (e *testChainEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	var request testRequest
+	json.Unmarshal(body, &request)
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.calls[request.Method]++
+
+	var param string
+	if len(request.Params) > 0 {
+		json.Unmarshal(request.Params[0], &param)
+	}
+
+	switch request.Method {
+	case "eth_blockNumber":
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x%x"}`, request.ID, e.head)
+	case "eth_getBlockByNumber":
+		blockNum, _ := strconv.ParseUint(strings.TrimPrefix(param, "0x"), 16, 64)
+
+		branch := "aa"
+		if e.reorged && blockNum >= e.forkBlock && blockNum >= e.laggingBelow {
+			branch = "bb"
+		}
+		e.writeBlock(w, request.ID, branch, blockNum)
+	case "eth_getBlockByHash":
+		branch := param[2:4]
+		blockNum, _ := strconv.ParseUint(param[4:], 16, 64)
+
+		if branch == "bb" && (!e.reorged || blockNum < e.forkBlock) {
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":null}`, request.ID)
+			return
+		}
+		e.writeBlock(w, request.ID, branch, blockNum)
+	}
+}
+
+func (e *testChainEndpoint) writeBlock(w io.Writer, id json.RawMessage, branch string, blockNum uint64) {
+	parentBranch := branch
+	if blockNum-1 < e.forkBlock {
+		parentBranch = "aa"
+	}
+	fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{"number":"0x%x","hash":"0x%s","parentHash":"0x%s","timestamp":"0x%x","transactions":[]}}`, id, blockNum, testChainBlockHash(branch, blockNum), testChainBlockHash(parentBranch, blockNum-1), 1_700_000_000+blockNum)
+}
+
+type testBlockHandler struct {
+	onHandle func(blk *pbbstream.Block)
+	fired    []*pbbstream.Block
+}
+
+func (h *testBlockHandler) Init() {}
+
+func (h *testBlockHandler) Handle(blk *pbbstream.Block) error {
+	h.fired = append(h.fired, blk)
+	h.onHandle(blk)
+	return nil
+}
+
+func TestBlockFetcher_PollerReorg(t *testing.T) {
+	tests := []struct {
+		name         string
+		laggingBelow uint64
+	}{
+		{"node up to date", 0},
+		{"node lagging on the reorged blocks", 12},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			endpoint := &testChainEndpoint{head: 30, forkBlock: 8, laggingBelow: test.laggingBelow, calls: map[string]int{}}
+			server := httptest.NewServer(endpoint)
+			defer server.Close()
+
+			// the chain reorganizes once block 10 is fired, while the next blocks are being fetched concurrently
+			handler := &testBlockHandler{onHandle: func(blk *pbbstream.Block) {
+				if blk.Number == 10 {
+					endpoint.reorg()
+				}
+			}}
+
+			clients := firecorerpc.NewClients[*rpc.Client](5*time.Second, firecorerpc.NewStickyRollingStrategy[*rpc.Client](), zap.NewNop())
+			clients.Add(rpc.NewClient(server.URL))
+
+			fetcher := NewGenericBlockFetcher(0, 10*time.Millisecond, zap.NewNop())
+			poller := blockpoller.New[*rpc.Client](fetcher, fetcher.TrackFiredBlocks(handler), clients)
+
+			stopBlock := uint64(25)
+			done := make(chan error, 1)
+			go func() { done <- poller.Run(1, &stopBlock, 5) }()
+
+			select {
+			case err := <-done:
+				require.NoError(t, err)
+			case <-time.After(10 * time.Second):
+				t.Fatal("poller did not reach the stop block")
+			}
+
+			fired := map[string]bool{}
+			for i, blk := range handler.fired {
+				if i > 0 {
+					assert.True(t, fired[blk.ParentId], "block %d %s fired before its parent %s", blk.Number, blk.Id, blk.ParentId)
+				}
+				fired[blk.Id] = true
+			}
+
+			assert.True(t, fired[testChainBlockHash("aa", 10)], "block 10 of the old branch should be fired before the reorg")
+
+			head := handler.fired[len(handler.fired)-1]
+			assert.Equal(t, stopBlock-1, head.Number)
+			assert.Equal(t, testChainBlockHash("bb", stopBlock-1), head.Id)
+			for blockNum := endpoint.forkBlock; blockNum < stopBlock; blockNum++ {
+				assert.True(t, fired[testChainBlockHash("bb", blockNum)], "block %d of the new branch should be fired", blockNum)
+			}
+
+			assert.NotZero(t, endpoint.calls["eth_getBlockByHash"], "new branch should be walked back by hash")
+		})
+	}
+}
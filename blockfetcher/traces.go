@@ -0,0 +1,86 @@
+package blockfetcher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/streamingfast/eth-go"
+	"github.com/streamingfast/eth-go/rpc"
+	"github.com/streamingfast/firehose-ethereum/block"
+)
+
+type rpcTraceResult struct {
+	TxHash eth.Hash        `json:"txHash"`
+	Result json.RawMessage `json:"result"`
+	Error  string          `json:"error"`
+}
+
+// FetchCallTraces fetches the call frame of each transaction of the block with the `callTracer`
+func FetchCallTraces(ctx context.Context, client *rpc.Client, rpcBlock *block.RpcBlock) (out []*block.RpcCallFrame, err error) {
+	results, err := traceBlock(ctx, client, rpcBlock, map[string]interface{}{"tracer": "callTracer"})
+	if err != nil {
+		return nil, err
+	}
+
+	out = make([]*block.RpcCallFrame, len(results))
+	for i, result := range results {
+		if err := json.Unmarshal(result, &out[i]); err != nil {
+			return nil, fmt.Errorf("unable to decode call trace of transaction #%d: %w", i, err)
+		}
+	}
+	return out, nil
+}
+
+// FetchStateDiffs fetches the state diff of each transaction of the block with the `prestateTracer` in diff mode
+func FetchStateDiffs(ctx context.Context, client *rpc.Client, rpcBlock *block.RpcBlock) (out []*block.RpcStateDiff, err error) {
+	results, err := traceBlock(ctx, client, rpcBlock, map[string]interface{}{
+		"tracer":       "prestateTracer",
+		"tracerConfig": map[string]interface{}{"diffMode": true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out = make([]*block.RpcStateDiff, len(results))
+	for i, result := range results {
+		if err := json.Unmarshal(result, &out[i]); err != nil {
+			return nil, fmt.Errorf("unable to decode state diff of transaction #%d: %w", i, err)
+		}
+	}
+	return out, nil
+}
+
+// traceBlock returns the tracer result of each transaction of the block, in the order of the block transactions.
+// The block is traced by hash so that the results are for the fetched block even if the chain reorganized since,
+// there must be one per transaction and those carrying a transaction hash (recent geth versions) are checked
+// against the block transactions.
+func traceBlock(ctx context.Context, client *rpc.Client, rpcBlock *block.RpcBlock, tracer map[string]interface{}) ([]json.RawMessage, error) {
+	resp, err := client.DoRequest(ctx, "debug_traceBlockByHash", []interface{}{rpcBlock.Hash, tracer})
+	if err != nil {
+		return nil, fmt.Errorf("unable to perform debug_traceBlockByHash request with %s: %w", tracer["tracer"], err)
+	}
+
+	var results []*rpcTraceResult
+	if err := json.Unmarshal([]byte(resp), &results); err != nil {
+		return nil, fmt.Errorf("unable to decode %s results from JSON: %w", tracer["tracer"], err)
+	}
+
+	transactions := rpcBlock.Transactions.Transactions
+	if len(results) != len(transactions) {
+		return nil, fmt.Errorf("got %d %s results for %d transactions", len(results), tracer["tracer"], len(transactions))
+	}
+
+	out := make([]json.RawMessage, len(results))
+	for i, result := range results {
+		if result.TxHash != nil && !bytes.Equal(result.TxHash, transactions[i].Hash) {
+			return nil, fmt.Errorf("%s result #%d is for transaction %s, expected %s", tracer["tracer"], i, result.TxHash.Pretty(), transactions[i].Hash.Pretty())
+		}
+		if result.Error != "" {
+			return nil, fmt.Errorf("%s failed on transaction %s: %s", tracer["tracer"], transactions[i].Hash.Pretty(), result.Error)
+		}
+		out[i] = result.Result
+	}
+	return out, nil
+}
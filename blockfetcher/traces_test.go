@@ -0,0 +1,87 @@
+package blockfetcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	"github.com/streamingfast/eth-go/rpc"
+	"github.com/streamingfast/firehose-ethereum/block"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+// testTracesEndpoint answers `debug_traceBlockByHash` with one empty result per given result transaction hash
+type testTracesEndpoint struct {
+	results []string
+	calls   []string
+}
+
+func (e *testTracesEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	var request testRequest
+	json.Unmarshal(body, &request)
+
+	var hash string
+	json.Unmarshal(request.Params[0], &hash)
+	e.calls = append(e.calls, request.Method+" "+hash)
+
+	results := make([]string, len(e.results))
+	for i, txHash := range e.results {
+		results[i] = fmt.Sprintf(`{"txHash":"%s","result":{}}`, txHash)
+	}
+	fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":[%s]}`, request.ID, strings.Join(results, ","))
+}
+
+func TestTraceBlock(t *testing.T) {
+	rpcBlock := &block.RpcBlock{Block: &rpc.Block{
+		Number: 10,
+		Hash:   eth.MustNewHash("0xaa"),
+		Transactions: &rpc.BlockTransactions{Transactions: []rpc.Transaction{
+			{Hash: eth.MustNewHash("0x01")},
+			{Hash: eth.MustNewHash("0x02")},
+		}},
+	}}
+	tx1, tx2 := eth.MustNewHash("0x01").Pretty(), eth.MustNewHash("0x02").Pretty()
+
+	tests := []struct {
+		name        string
+		results     []string
+		expectedErr string
+	}{
+		{"one result per transaction", []string{tx1, tx2}, ""},
+		{"missing result", []string{tx1}, "got 1 callTracer results for 2 transactions"},
+		{"other transaction", []string{tx2, tx1}, "callTracer result #0 is for transaction " + tx2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			endpoint := &testTracesEndpoint{results: test.results}
+			server := httptest.NewServer(endpoint)
+			defer server.Close()
+
+			frames, err := FetchCallTraces(context.Background(), rpc.NewClient(server.URL), rpcBlock)
+			assert.Equal(t, []string{"debug_traceBlockByHash " + rpcBlock.Hash.Pretty()}, endpoint.calls, "block should be traced by hash")
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, frames, 2)
+		})
+	}
+}
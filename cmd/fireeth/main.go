@@ -9,7 +9,9 @@ import (
 	"github.com/spf13/viper"
 	"github.com/streamingfast/bstream"
 	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	bstransform "github.com/streamingfast/bstream/transform"
 	"github.com/streamingfast/cli"
+	"github.com/streamingfast/dstore"
 	firecore "github.com/streamingfast/firehose-core"
 	fhCmd "github.com/streamingfast/firehose-core/cmd"
 	"github.com/streamingfast/firehose-core/firehose/info"
@@ -58,7 +60,8 @@ func Chain() *firecore.Chain[*pbeth.Block] {
 
 		BlockTransformerFactories: map[protoreflect.FullName]firecore.BlockTransformerFactory{
 			transform.HeaderOnlyMessageName:     transform.NewHeaderOnlyTransformFactory,
-			transform.CombinedFilterMessageName: transform.NewCombinedFilterTransformFactory,
+			transform.CombinedFilterMessageName: withLogsBloomFlag(transform.CombinedFilterTransformFactoryWithLogsBloom),
+			transform.TrimmedFilterMessageName:  withLogsBloomFlag(transform.TrimmedFilterTransformFactoryWithLogsBloom),
 
 			transform.MultiCallToFilterMessageName: transform.NewMultiCallToFilterTransformFactory,
 			transform.MultiLogFilterMessageName:    transform.NewMultiLogFilterTransformFactory,
@@ -76,6 +79,7 @@ func Chain() *firecore.Chain[*pbeth.Block] {
 
 			flags.StringArray("substreams-rpc-endpoints", nil, "Remote endpoints to contact to satisfy Substreams 'eth_call's")
 			flags.Uint64("substreams-rpc-gas-limit", 50_000_000, "Gas limit to set when calling RPC (set it to 0 for arbitrum chains, otherwise you should keep 50M)")
+			flags.Bool("firehose-logs-bloom-filtering", false, "Skip the transactions of the blocks not covered by the combined index whose header logs bloom proves that they don't match the log filters of a request (must stay off on polygon chains, whose state sync logs are not part of the header logs bloom)")
 		},
 
 		RegisterSubstreamsExtensions: func() (wasm.WASMExtensioner, error) {
@@ -116,6 +120,7 @@ func Chain() *firecore.Chain[*pbeth.Block] {
 				parent.AddCommand(newScanForUnknownStatusCmd(zlog))
 
 				registerGethEnforcePeersCmd(parent, chain.BinaryName(), zlog, tracer)
+				registerIndexCmd(parent, chain.BinaryName(), zlog)
 
 				return nil
 			},
@@ -123,9 +128,14 @@ func Chain() *firecore.Chain[*pbeth.Block] {
 			TransformFlags: &firecore.TransformFlags{
 				Register: func(flags *pflag.FlagSet) {
 					flags.Bool("header-only", false, "Apply the HeaderOnly transform sending back Block's header only (with few top-level fields), exclusive option")
-					flags.String("call-filters", "", "call filters (format: '[address1[+address2[+...]]]:[eventsig1[+eventsig2[+...]]]")
-					flags.String("log-filters", "", "log filters (format: '[address1[+address2[+...]]]:[eventsig1[+eventsig2[+...]]]")
+					flags.String("call-filters", "", "call filters separated by comma or new line (format: '[address1[+address2[+...]]]:[methodsig1[+methodsig2[+...]]][:caller1[+caller2[+...]]]', a method signature is either a hex method id or a Solidity signature like 'transfer(address,uint256)', callers restrict the matched calls to those made by one of these addresses)")
+					flags.String("log-filters", "", "log filters separated by comma or new line (format: '[address1[+address2[+...]]]:[eventsig1[+eventsig2[+...]]][:[topic1[+...]][:[topic2[+...]][:[topic3[+...]]]]]', an event signature is either a hex hash or a Solidity signature like 'Transfer(address,address,uint256)')")
+					flags.String("filters-file", "", "YAML or JSON file defining 'call_filters' (with 'addresses', 'signatures', 'callers', 'min_depth', 'max_depth' and 'call_types'), 'log_filters' (with 'addresses', 'event_signatures', 'topic1', 'topic2' and 'topic3') and 'send_all_block_headers', added to the filters of the other flags")
 					flags.Bool("send-all-block-headers", false, "ask for all the blocks to be sent (header-only if there is no match)")
+					flags.Bool("exclude-failed-transactions", false, "never match failed or reverted transactions, requires 'call-filters' or 'log-filters'")
+					flags.Bool("exclude-reverted-calls", false, "ignore reverted calls and the logs of failed transactions when evaluating 'call-filters' and 'log-filters'")
+					flags.Bool("trim", false, "prune the calls of the matching transactions from the data not listed in --trim-keep, requires 'call-filters', 'log-filters' or 'send-all-block-headers'")
+					flags.StringSlice("trim-keep", nil, "call fields to keep when --trim is set, one of "+strings.Join(transform.TrimmableCallFields, ", "))
 				},
 
 				Parse: parseTransformFlags,
@@ -146,7 +156,7 @@ var fillChainInfoResponse = func(block *pbbstream.Block, resp *pbfirehose.InfoRe
 
 	var detailLevelFromConfig string
 	for _, feature := range resp.BlockFeatures {
-		if feature == "base" || feature == "extended" || feature == "hybrid" {
+		if feature == "base" || feature == "trace" || feature == "extended" || feature == "hybrid" {
 			detailLevelFromConfig = feature
 			break
 		}
@@ -162,6 +172,8 @@ var fillChainInfoResponse = func(block *pbbstream.Block, resp *pbfirehose.InfoRe
 		switch ethBlock.DetailLevel {
 		case pbeth.Block_DETAILLEVEL_BASE:
 			detailLevelFromBlock = "base"
+		case pbeth.Block_DETAILLEVEL_TRACE:
+			detailLevelFromBlock = "trace"
 		case pbeth.Block_DETAILLEVEL_EXTENDED:
 			detailLevelFromBlock = "extended"
 		default:
@@ -194,3 +206,11 @@ var fillChainInfoResponse = func(block *pbbstream.Block, resp *pbfirehose.InfoRe
 
 	return nil
 }
+
+// withLogsBloomFlag turns a filter transform factory into a firecore.BlockTransformerFactory whose block header
+// logs bloom check is turned on or off by the 'firehose-logs-bloom-filtering' flag
+func withLogsBloomFlag(factory func(dstore.Store, []uint64, bool) *bstransform.Factory) firecore.BlockTransformerFactory {
+	return func(indexStore dstore.Store, possibleIndexSizes []uint64) (*bstransform.Factory, error) {
+		return factory(indexStore, possibleIndexSizes, viper.GetBool("firehose-logs-bloom-filtering")), nil
+	}
+}
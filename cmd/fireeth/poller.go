@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -30,28 +31,24 @@ func newPollerCmd(logger *zap.Logger, tracer logging.Tracer) *cobra.Command {
 }
 
 func newOptimismPollerCmd(logger *zap.Logger, tracer logging.Tracer) *cobra.Command {
-	// identical as generic-evm for now
 	cmd := &cobra.Command{
 		Use:   "optimism <rpc-endpoint> <first-streamable-block>",
 		Short: "poll blocks from optimism rpc",
 		Args:  cobra.ExactArgs(2),
-		RunE:  pollerRunE(logger, tracer),
+		RunE:  pollerRunE(logger, tracer, newOptimismFetcher),
 	}
-	cmd.Flags().Duration("interval-between-fetch", 0, "interval between fetch")
-	cmd.Flags().Duration("max-block-fetch-duration", 5*time.Second, "maximum delay before retrying a block fetch")
+	addPollerFlags(cmd)
 
 	return cmd
 }
 func newArbOnePollerCmd(logger *zap.Logger, tracer logging.Tracer) *cobra.Command {
-	// identical as generic-evm for now
 	cmd := &cobra.Command{
 		Use:   "arb-one <rpc-endpoint> <first-streamable-block>",
 		Short: "poll blocks from arb-one rpc",
 		Args:  cobra.ExactArgs(2),
-		RunE:  pollerRunE(logger, tracer),
+		RunE:  pollerRunE(logger, tracer, newArbOneFetcher),
 	}
-	cmd.Flags().Duration("interval-between-fetch", 0, "interval between fetch")
-	cmd.Flags().Duration("max-block-fetch-duration", 5*time.Second, "maximum delay before retrying a block fetch")
+	addPollerFlags(cmd)
 
 	return cmd
 }
@@ -60,20 +57,96 @@ func newGenericEVMPollerCmd(logger *zap.Logger, tracer logging.Tracer) *cobra.Co
 		Use:   "generic-evm <rpc-endpoint> <first-streamable-block>",
 		Short: "poll blocks from a generic EVM RPC endpoint",
 		Args:  cobra.ExactArgs(2),
-		RunE:  pollerRunE(logger, tracer),
+		RunE:  pollerRunE(logger, tracer, newGenericEVMFetcher),
 	}
+	addPollerFlags(cmd)
+
+	return cmd
+}
+
+func addPollerFlags(cmd *cobra.Command) {
 	cmd.Flags().Duration("interval-between-fetch", 0, "interval between fetch")
 	cmd.Flags().Duration("max-block-fetch-duration", 5*time.Second, "maximum delay before retrying a block fetch")
+	cmd.Flags().Bool("call-traces", false, "add the calls of each transaction to the blocks using the 'callTracer' of 'debug_traceBlockByHash', producing blocks of detail level TRACE (requires the 'debug' namespace on the RPC endpoint)")
+	addFinalityFlags(cmd, 200)
+	cmd.Flags().String("receipts-strategy", "auto", "how the receipts of the blocks are fetched, one of 'block-receipts' (eth_getBlockReceipts), 'batch' (JSON-RPC batches of eth_getTransactionReceipt), 'per-transaction' (one eth_getTransactionReceipt request per transaction) or 'auto' (the first one supported by each endpoint, in this order)")
+	cmd.Flags().Int("receipts-batch-size", 100, "number of eth_getTransactionReceipt requests per JSON-RPC batch with the 'batch' receipts strategy")
+	cmd.Flags().Int("receipts-concurrency", 10, "number of receipt requests (or batches) performed concurrently with the 'batch' and 'per-transaction' receipts strategies")
+	cmd.Flags().Bool("state-diffs", false, "with --call-traces, also add the storage, balance, nonce and code changes of each transaction to its root call using the 'prestateTracer' in diff mode")
+}
 
-	return cmd
+func addFinalityFlags(cmd *cobra.Command, defaultDepth uint64) {
+	cmd.Flags().String("finality", "depth", "how the LIB of the blocks is determined, one of 'depth' (--finality-depth blocks behind the block), 'finalized' or 'safe' (number of the block having this tag on the RPC endpoint), 'optimism-finalized' or 'optimism-safe' (L2 head of this kind in the 'optimism_syncStatus' of --rollup-node-endpoint)")
+	cmd.Flags().Uint64("finality-depth", defaultDepth, "number of blocks behind the block considered final with the 'depth' finality")
+	cmd.Flags().String("rollup-node-endpoint", "", "RPC endpoint of the rollup node (op-node) used by the 'optimism-finalized' and 'optimism-safe' finality")
+}
+
+func newFinalityProvider(cmd *cobra.Command) (blockfetcher.FinalityProvider, error) {
+	finality := sflags.MustGetString(cmd, "finality")
+	switch finality {
+	case "depth":
+		return blockfetcher.NewFixedDepthFinality(sflags.MustGetUint64(cmd, "finality-depth")), nil
+	case "finalized", "safe":
+		return blockfetcher.NewBlockTagFinality(finality), nil
+	case "optimism-finalized", "optimism-safe":
+		rollupNodeEndpoint := sflags.MustGetString(cmd, "rollup-node-endpoint")
+		if rollupNodeEndpoint == "" {
+			return nil, fmt.Errorf("--finality %q requires --rollup-node-endpoint", finality)
+		}
+		head := strings.TrimPrefix(finality, "optimism-") + "_l2"
+		return blockfetcher.NewOptimismSyncStatusFinality(rpc.NewClient(rollupNodeEndpoint), head), nil
+	default:
+		return nil, fmt.Errorf("invalid --finality %q, valid values are 'depth', 'finalized', 'safe', 'optimism-finalized' and 'optimism-safe'", finality)
+	}
+}
+
+// pollerBlockFetcher is implemented by the chain-specific block fetchers, which all embed a blockfetcher.BlockFetcher
+type pollerBlockFetcher interface {
+	blockpoller.BlockFetcher[*rpc.Client]
+	SetFinalityProvider(finality blockfetcher.FinalityProvider)
+	SetReceiptsFetcher(receiptsFetcher *blockfetcher.ReceiptsFetcher)
+	EnableCallTraces(withStateDiffs bool)
+	TrackFiredBlocks(handler blockpoller.BlockHandler) blockpoller.BlockHandler
 }
 
-func pollerRunE(logger *zap.Logger, tracer logging.Tracer) firecore.CommandExecutor {
+func newOptimismFetcher(fetchInterval time.Duration, logger *zap.Logger) pollerBlockFetcher {
+	return blockfetcher.NewOptimismBlockFetcher(fetchInterval, 1*time.Second, logger)
+}
+
+func newGenericEVMFetcher(fetchInterval time.Duration, logger *zap.Logger) pollerBlockFetcher {
+	return blockfetcher.NewGenericBlockFetcher(fetchInterval, 1*time.Second, logger)
+}
+
+func newArbOneFetcher(fetchInterval time.Duration, logger *zap.Logger) pollerBlockFetcher {
+	return blockfetcher.NewArbOneBlockFetcher(fetchInterval, 1*time.Second, logger)
+}
+
+func pollerRunE(logger *zap.Logger, tracer logging.Tracer, newFetcher func(fetchInterval time.Duration, logger *zap.Logger) pollerBlockFetcher) firecore.CommandExecutor {
 	return func(cmd *cobra.Command, args []string) (err error) {
 		rpcEndpoint := args[0]
 		//dataDir := cmd.Flag("data-dir").Value.String()
 		fetchInterval := sflags.MustGetDuration(cmd, "interval-between-fetch")
 		maxBlockFetchDuration := sflags.MustGetDuration(cmd, "max-block-fetch-duration")
+		callTraces := sflags.MustGetBool(cmd, "call-traces")
+		stateDiffs := sflags.MustGetBool(cmd, "state-diffs")
+		if stateDiffs && !callTraces {
+			return fmt.Errorf("--state-diffs requires --call-traces")
+		}
+
+		finality, err := newFinalityProvider(cmd)
+		if err != nil {
+			return err
+		}
+
+		receiptsStrategy, err := blockfetcher.ParseReceiptsStrategy(sflags.MustGetString(cmd, "receipts-strategy"))
+		if err != nil {
+			return err
+		}
+		receiptsBatchSize := sflags.MustGetInt(cmd, "receipts-batch-size")
+		receiptsConcurrency := sflags.MustGetInt(cmd, "receipts-concurrency")
+		if receiptsBatchSize <= 0 || receiptsConcurrency <= 0 {
+			return fmt.Errorf("--receipts-batch-size and --receipts-concurrency must be greater than 0")
+		}
 
 		dataDir := sflags.MustGetString(cmd, "data-dir")
 		stateDir := path.Join(dataDir, "poller-state")
@@ -89,13 +162,24 @@ func pollerRunE(logger *zap.Logger, tracer logging.Tracer) firecore.CommandExecu
 			zap.String("state_dir", stateDir),
 			zap.Duration("fetch_interval", fetchInterval),
 			zap.Duration("max_block_fetch_duration", maxBlockFetchDuration),
+			zap.String("finality", sflags.MustGetString(cmd, "finality")),
+			zap.Bool("call_traces", callTraces),
+			zap.Bool("state_diffs", stateDiffs),
+			zap.String("receipts_strategy", string(receiptsStrategy)),
+			zap.Int("receipts_batch_size", receiptsBatchSize),
+			zap.Int("receipts_concurrency", receiptsConcurrency),
 			zap.Uint64("first_streamable_block", firstStreamableBlock),
 		)
 		rpcClients := firecorerpc.NewClients[*rpc.Client](maxBlockFetchDuration, firecorerpc.NewStickyRollingStrategy[*rpc.Client](), logger)
 		rpcClients.Add(rpc.NewClient(rpcEndpoint))
 
-		fetcher := blockfetcher.NewOptimismBlockFetcher(fetchInterval, 1*time.Second, logger)
-		handler := blockpoller.NewFireBlockHandler("type.googleapis.com/sf.ethereum.type.v2.Block")
+		fetcher := newFetcher(fetchInterval, logger)
+		fetcher.SetFinalityProvider(finality)
+		fetcher.SetReceiptsFetcher(blockfetcher.NewReceiptsFetcher(receiptsStrategy, receiptsBatchSize, receiptsConcurrency, logger))
+		if callTraces {
+			fetcher.EnableCallTraces(stateDiffs)
+		}
+		handler := fetcher.TrackFiredBlocks(blockpoller.NewFireBlockHandler("type.googleapis.com/sf.ethereum.type.v2.Block"))
 		poller := blockpoller.New[*rpc.Client](fetcher, handler, rpcClients, blockpoller.WithStoringState[*rpc.Client](stateDir), blockpoller.WithLogger[*rpc.Client](logger))
 
 		err = poller.Run(firstStreamableBlock, nil, 1)
@@ -92,12 +92,12 @@ func createCompareBlocksStoreRPCE(logger *zap.Logger) firecore.CommandExecutor {
 				return fmt.Errorf("unmarshalling pbeth block: %w", err)
 			}
 
-			rpcBlock, err := rpcClient.GetBlockByNumber(ctx, rpc.BlockNumber(ethBlock.Number), rpc.WithGetBlockFullTransaction())
+			rpcBlock, err := blockfetcher.FetchBlock(ctx, rpcClient, ethBlock.Number)
 			if err != nil {
 				panic(err)
 			}
 
-			receipts, err := blockfetcher.FetchReceipts(ctx, rpcBlock, rpcClient)
+			receipts, err := blockfetcher.FetchReceipts(ctx, rpcBlock.Block, rpcClient)
 			if err != nil {
 				panic(err)
 			}
@@ -128,12 +128,12 @@ func createCompareBlocksRPCE(logger *zap.Logger) firecore.CommandExecutor {
 		go func() {
 
 			for firehoseBlock := range respChan {
-				rpcBlock, err := cli.GetBlockByNumber(ctx, rpc.BlockNumber(firehoseBlock.Number), rpc.WithGetBlockFullTransaction())
+				rpcBlock, err := blockfetcher.FetchBlock(ctx, cli, firehoseBlock.Number)
 				if err != nil {
 					panic(err)
 				}
 
-				receipts, err := blockfetcher.FetchReceipts(ctx, rpcBlock, cli)
+				receipts, err := blockfetcher.FetchReceipts(ctx, rpcBlock.Block, cli)
 				if err != nil {
 					panic(err)
 				}
@@ -375,7 +375,7 @@ func stripFirehoseTrxReceipt(in *pbeth.TransactionReceipt) {
 	in.StateRoot = nil // only available on getTransactionReceipt
 }
 
-func CompareFirehoseToRPC(fhBlock *pbeth.Block, rpcBlock *rpc.Block, receipts map[string]*rpc.TransactionReceipt, saveFiles bool) (isEqual bool, differences []string) {
+func CompareFirehoseToRPC(fhBlock *pbeth.Block, rpcBlock *block.RpcBlock, receipts map[string]*block.RpcReceipt, saveFiles bool) (isEqual bool, differences []string) {
 	if fhBlock == nil && rpcBlock == nil {
 		return true, nil
 	}
@@ -384,6 +384,7 @@ func CompareFirehoseToRPC(fhBlock *pbeth.Block, rpcBlock *rpc.Block, receipts ma
 	stripFirehoseBlock(fhBlock, hashesWithoutTo)
 
 	// tweak that new block for comparison
+	rpcAsPBEth.Withdrawals = nil // extended Firehose blocks represent withdrawals as balance changes
 	for _, tx := range rpcAsPBEth.TransactionTraces {
 		tx.BeginOrdinal = 0
 		tx.EndOrdinal = 0
@@ -68,12 +68,12 @@ func compareOneblockRPCE() firecore.CommandExecutor {
 
 		cli := rpc.NewClient(rpcEndpoint)
 
-		rpcBlock, err := cli.GetBlockByNumber(ctx, rpc.BlockNumber(fhBlock.Number), rpc.WithGetBlockFullTransaction())
+		rpcBlock, err := blockfetcher.FetchBlock(ctx, cli, fhBlock.Number)
 		if err != nil {
 			return err
 		}
 
-		receipts, err := blockfetcher.FetchReceipts(ctx, rpcBlock, cli)
+		receipts, err := blockfetcher.FetchReceipts(ctx, rpcBlock.Block, cli)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	. "github.com/streamingfast/cli"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultIndexSizes are the index sizes looked up when --index-sizes is not provided, the same default
+// as the one of the Firehose index provider
+var defaultIndexSizes = []int{100000, 10000, 1000, 100}
+
+// defaultBundleSize is the size of the merged blocks files, the smallest range an index file can cover
+const defaultBundleSize = 100
+
+func registerIndexCmd(parent *cobra.Command, binary string, logger *zap.Logger) {
+	registerGroup(parent,
+		Group("index", "Tools to inspect the combined index files produced by the index-builder, without reading merged blocks",
+			Command(createIndexQueryE(logger),
+				"query <index-store> <start-block> <stop-block>",
+				"Print the blocks of the [start-block, stop-block) range that the combined index reports as matching the filters",
+				ExactArgs(3),
+				Flags(func(flags *pflag.FlagSet) {
+					registerIndexSizesFlag(flags)
+					registerBundleSizeFlag(flags)
+					flags.String("call-filters", "", "call filters, same format as the 'firehose-client' tool flag")
+					flags.String("log-filters", "", "log filters, same format as the 'firehose-client' tool flag")
+					flags.String("filters-file", "", "YAML or JSON filters file, same format as the 'firehose-client' tool flag")
+				}),
+				ExamplePrefixed(fmt.Sprintf("%s tools index query", binary), `
+					# Blocks containing USDC Transfer events
+					gs://bucket/index 17000000 17100000 --log-filters='0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48:Transfer(address,address,uint256)'
+				`),
+			),
+			Command(createIndexStatsE(logger),
+				"stats <index-store>",
+				"Report the key counts, bitmap sizes, missing features and gaps of each index file and the keys present in the most blocks",
+				ExactArgs(1),
+				Flags(func(flags *pflag.FlagSet) {
+					flags.Uint64("start-block", 0, "Only report the index files containing blocks from this block")
+					flags.Uint64("stop-block", 0, "Only report the index files containing blocks before this block, 0 for no limit")
+					flags.Uint64("index-size", 0, "Only report the index files of this size, 0 for all sizes (top keys are counted once per index file, so files of different sizes covering the same blocks count them more than once)")
+					flags.Int("top", 10, "Number of values to list for each kind of key")
+					registerBundleSizeFlag(flags)
+				}),
+				ExamplePrefixed(fmt.Sprintf("%s tools index stats", binary), `
+					gs://bucket/index --start-block=17000000 --stop-block=18000000 --top=20
+				`),
+			),
+			Command(createIndexVerifyE(logger),
+				"verify <index-store> <merged-blocks-store> <start-block> <stop-block>",
+				"Re-derive the index files fully within [start-block, stop-block) from the merged blocks and report the blocks missing from or extra in the stored bitmaps of each key, as well as the ranges no index file covers",
+				ExactArgs(4),
+				Flags(func(flags *pflag.FlagSet) {
+					registerBundleSizeFlag(flags)
+					flags.Bool("rewrite", false, "Overwrite the mismatching index files with the ones derived from the merged blocks")
+					flags.Int("max-reported-blocks", 10, "Maximum number of missing or extra block numbers printed for each key")
+				}),
+				ExamplePrefixed(fmt.Sprintf("%s tools index verify", binary), `
+					gs://bucket/index gs://bucket/merged-blocks 17000000 17100000
+
+					# Fix the mismatching index files
+					gs://bucket/index gs://bucket/merged-blocks 17000000 17100000 --rewrite
+				`),
+			),
+		),
+	)
+}
+
+func registerIndexSizesFlag(flags *pflag.FlagSet) {
+	flags.IntSlice("index-sizes", defaultIndexSizes, "Possible sizes of the index files, largest first, the largest one found is used for a range")
+}
+
+func registerBundleSizeFlag(flags *pflag.FlagSet) {
+	flags.Uint64("bundle-size", defaultBundleSize, "Size of the merged blocks files the index files were built from, index files cover a multiple of it")
+}
+
+func newIndexStore(url string, overwrite bool) (dstore.Store, error) {
+	store, err := dstore.NewStore(url, "", "", overwrite)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create index store: %w", err)
+	}
+	return store, nil
+}
+
+// indexSizesFlag returns the value of the 'index-sizes' flag
+func indexSizesFlag(cmd *cobra.Command) ([]uint64, error) {
+	sizes := sflags.MustGetIntSlice(cmd, "index-sizes")
+
+	out := make([]uint64, len(sizes))
+	for i, size := range sizes {
+		if size <= 0 {
+			return nil, fmt.Errorf("invalid index size %d, must be positive", size)
+		}
+		out[i] = uint64(size)
+	}
+	return out, nil
+}
+
+// bundleSizeFlag returns the value of the 'bundle-size' flag
+func bundleSizeFlag(cmd *cobra.Command) (uint64, error) {
+	size := sflags.MustGetUint64(cmd, "bundle-size")
+	if size == 0 {
+		return 0, fmt.Errorf("invalid bundle size 0, must be positive")
+	}
+	return size, nil
+}
+
+// readIndexFile reads the index file from the store, also returning its size in bytes
+func readIndexFile(ctx context.Context, store dstore.Store, filename string) (index *pbbstream.GenericBlockIndex, fileBytes int, err error) {
+	reader, err := store.OpenObject(ctx, filename)
+	if err != nil {
+		return nil, 0, fmt.Errorf("opening %s: %w", filename, err)
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, 0, fmt.Errorf("reading %s: %w", filename, err)
+	}
+
+	index = &pbbstream.GenericBlockIndex{}
+	if err := proto.Unmarshal(content, index); err != nil {
+		return nil, 0, fmt.Errorf("unmarshalling %s: %w", filename, err)
+	}
+	return index, len(content), nil
+}
+
+// parseIndexFilename parses the `<base_block>.<size>.<short_name>.idx` index filenames
+func parseIndexFilename(filename string) (baseBlock, size uint64, shortName string, err error) {
+	parts := strings.Split(filename, ".")
+	if len(parts) != 4 || parts[3] != "idx" {
+		return 0, 0, "", fmt.Errorf("invalid index filename %q", filename)
+	}
+
+	if baseBlock, err = strconv.ParseUint(parts[0], 10, 64); err != nil {
+		return 0, 0, "", fmt.Errorf("invalid index filename %q: %w", filename, err)
+	}
+	if size, err = strconv.ParseUint(parts[1], 10, 64); err != nil || size == 0 {
+		return 0, 0, "", fmt.Errorf("invalid index filename %q: invalid size", filename)
+	}
+	return baseBlock, size, parts[2], nil
+}
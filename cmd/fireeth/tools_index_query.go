@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	firecore "github.com/streamingfast/firehose-core"
+	"github.com/streamingfast/firehose-ethereum/transform"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func createIndexQueryE(logger *zap.Logger) firecore.CommandExecutor {
+	return func(cmd *cobra.Command, args []string) error {
+		indexStore, err := newIndexStore(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		start := mustParseUint64(args[1])
+		stop := mustParseUint64(args[2])
+		if stop <= start {
+			return fmt.Errorf("stop block must be greater than start block")
+		}
+
+		filters, err := parseFilterFlags(cmd, false)
+		if err != nil {
+			return err
+		}
+		if filters == nil {
+			return fmt.Errorf("at least one of 'call-filters', 'log-filters' or 'filters-file' is required")
+		}
+
+		indexSizes, err := indexSizesFlag(cmd)
+		if err != nil {
+			return err
+		}
+
+		// the granularity at which the index is queried
+		bundleSize, err := bundleSizeFlag(cmd)
+		if err != nil {
+			return err
+		}
+
+		message, err := anypb.New(filters)
+		if err != nil {
+			return err
+		}
+
+		t, err := transform.CombinedFilterTransformFactory(indexStore, indexSizes).NewFunc(message)
+		if err != nil {
+			return fmt.Errorf("invalid filters: %w", err)
+		}
+		provider := t.(*transform.CombinedFilter).GetIndexProvider()
+		if provider == nil {
+			return fmt.Errorf("filters cannot be resolved using the index")
+		}
+
+		var matching, unindexed uint64
+		for base := start - start%bundleSize; base < stop; base += bundleSize {
+			blocks, err := provider.BlocksInRange(base, bundleSize)
+			if err != nil {
+				// no index file covers this range, or it was produced before the keys required by the filters were indexed
+				logger.Debug("range not answerable by the index", zap.Uint64("base_block", base), zap.Error(err))
+				unindexed += min(base+bundleSize, stop) - max(base, start)
+				continue
+			}
+
+			for _, block := range blocks {
+				if block < start || block >= stop || block >= base+bundleSize {
+					continue
+				}
+				matching++
+				fmt.Println(block)
+			}
+		}
+
+		fmt.Fprintf(cmd.ErrOrStderr(), "Found %d matching blocks out of %d blocks in range [%d, %d)", matching, stop-start, start, stop)
+		if unindexed > 0 {
+			fmt.Fprintf(cmd.ErrOrStderr(), ", %d blocks are not covered by a usable index and would all have to be read", unindexed)
+		}
+		fmt.Fprintln(cmd.ErrOrStderr())
+		return nil
+	}
+}
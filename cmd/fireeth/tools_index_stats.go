@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	"github.com/streamingfast/firehose-ethereum/transform"
+	"go.uber.org/zap"
+)
+
+type indexBundleStats struct {
+	baseBlock uint64
+	size      uint64
+
+	fileBytes   int
+	bitmapBytes int
+	keys        int
+	blocks      uint64
+
+	missingFeatures []string
+}
+
+func createIndexStatsE(logger *zap.Logger) firecore.CommandExecutor {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		indexStore, err := newIndexStore(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		start := sflags.MustGetUint64(cmd, "start-block")
+		stop := sflags.MustGetUint64(cmd, "stop-block")
+		top := sflags.MustGetInt(cmd, "top")
+		onlySize := sflags.MustGetUint64(cmd, "index-size")
+		bundleSize, err := bundleSizeFlag(cmd)
+		if err != nil {
+			return err
+		}
+
+		var bundles []*indexBundleStats
+		// blocks count of each value of each kind of key, summed over all the reported index files
+		frequencies := make(map[string]map[string]uint64)
+
+		err = indexStore.Walk(ctx, "", func(filename string) error {
+			baseBlock, size, shortName, err := parseIndexFilename(filename)
+			if err != nil || shortName != transform.CombinedIndexerShortName {
+				logger.Debug("skipping file not being a combined index", zap.String("filename", filename))
+				return nil
+			}
+
+			if onlySize != 0 && size != onlySize {
+				return nil
+			}
+			if stop != 0 && baseBlock >= stop {
+				return io.EOF
+			}
+			if baseBlock+size <= start {
+				return nil
+			}
+
+			bundle, err := readIndexBundleStats(ctx, indexStore, filename, frequencies)
+			if err != nil {
+				return err
+			}
+			bundle.baseBlock = baseBlock
+			bundle.size = size
+
+			logger.Debug("read index file", zap.String("filename", filename), zap.Int("keys", bundle.keys))
+			bundles = append(bundles, bundle)
+			return nil
+		})
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("walking index store: %w", err)
+		}
+
+		if len(bundles) == 0 {
+			fmt.Println("No combined index file found")
+			return nil
+		}
+
+		printIndexBundles(bundles)
+		printIndexSizes(bundles)
+		printIndexGaps(bundles)
+		printMisalignedIndexFiles(bundles, bundleSize)
+		printTopIndexKeys(frequencies, top)
+		return nil
+	}
+}
+
+func readIndexBundleStats(ctx context.Context, store dstore.Store, filename string, frequencies map[string]map[string]uint64) (*indexBundleStats, error) {
+	index, fileBytes, err := readIndexFile(ctx, store, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &indexBundleStats{fileBytes: fileBytes, keys: len(index.Kv)}
+	markers := make(map[string]bool)
+	blocks := roaring64.NewBitmap()
+	for _, kv := range index.Kv {
+		bitmap := roaring64.NewBitmap()
+		if err := bitmap.UnmarshalBinary(kv.Bitmap); err != nil {
+			return nil, fmt.Errorf("unmarshalling bitmap of key %q in %s: %w", kv.Key, filename, err)
+		}
+		out.bitmapBytes += len(kv.Bitmap)
+		blocks.Or(bitmap)
+
+		kind, value := transform.IndexKeyKind(string(kv.Key))
+		if kind == transform.IndexKeyKindMarker {
+			markers[value] = true
+			continue
+		}
+
+		if frequencies[kind] == nil {
+			frequencies[kind] = make(map[string]uint64)
+		}
+		frequencies[kind][value] += bitmap.GetCardinality()
+	}
+	out.blocks = blocks.GetCardinality()
+
+	for _, feature := range transform.IndexFeatures {
+		if !markers[feature.Marker] {
+			out.missingFeatures = append(out.missingFeatures, feature.Name)
+		}
+	}
+	return out, nil
+}
+
+func printIndexBundles(bundles []*indexBundleStats) {
+	fmt.Println("Index files:")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  RANGE\tSIZE\tKEYS\tBLOCKS\tFILE BYTES\tBITMAP BYTES\tMISSING FEATURES")
+	for _, bundle := range bundles {
+		fmt.Fprintf(w, "  [%d, %d)\t%d\t%d\t%d\t%d\t%d\t%s\n", bundle.baseBlock, bundle.baseBlock+bundle.size, bundle.size, bundle.keys, bundle.blocks, bundle.fileBytes, bundle.bitmapBytes, strings.Join(bundle.missingFeatures, ", "))
+	}
+	w.Flush()
+	fmt.Println()
+}
+
+func printIndexSizes(bundles []*indexBundleStats) {
+	type sizeStats struct {
+		files, keys, fileBytes int
+	}
+
+	sizes := make(map[uint64]*sizeStats)
+	for _, bundle := range bundles {
+		if sizes[bundle.size] == nil {
+			sizes[bundle.size] = &sizeStats{}
+		}
+		sizes[bundle.size].files++
+		sizes[bundle.size].keys += bundle.keys
+		sizes[bundle.size].fileBytes += bundle.fileBytes
+	}
+
+	keys := make([]uint64, 0, len(sizes))
+	for size := range sizes {
+		keys = append(keys, size)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] > keys[j] })
+
+	fmt.Println("Index sizes:")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  SIZE\tFILES\tAVG KEYS\tAVG FILE BYTES\tAVG BYTES PER BLOCK")
+	for _, size := range keys {
+		stats := sizes[size]
+		fmt.Fprintf(w, "  %d\t%d\t%d\t%d\t%d\n", size, stats.files, stats.keys/stats.files, stats.fileBytes/stats.files, uint64(stats.fileBytes)/(uint64(stats.files)*size))
+	}
+	w.Flush()
+	fmt.Println()
+}
+
+// printIndexGaps prints the ranges between the first and last index files that no index file covers
+func printIndexGaps(bundles []*indexBundleStats) {
+	sorted := make([]*indexBundleStats, len(bundles))
+	copy(sorted, bundles)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].baseBlock < sorted[j].baseBlock })
+
+	var gaps []string
+	coveredUntil := sorted[0].baseBlock
+	for _, bundle := range sorted {
+		if bundle.baseBlock > coveredUntil {
+			gaps = append(gaps, fmt.Sprintf("[%d, %d)", coveredUntil, bundle.baseBlock))
+		}
+		coveredUntil = max(coveredUntil, bundle.baseBlock+bundle.size)
+	}
+
+	if len(gaps) == 0 {
+		fmt.Printf("Index gaps: none, [%d, %d) is fully covered\n\n", sorted[0].baseBlock, coveredUntil)
+		return
+	}
+
+	fmt.Printf("Index gaps (%d):\n", len(gaps))
+	for _, gap := range gaps {
+		fmt.Printf("  %s\n", gap)
+	}
+	fmt.Println()
+}
+
+// printMisalignedIndexFiles prints the index files not starting and ending on a merged blocks file boundary,
+// the index provider never looks them up
+func printMisalignedIndexFiles(bundles []*indexBundleStats, bundleSize uint64) {
+	var misaligned []string
+	for _, bundle := range bundles {
+		if bundle.baseBlock%bundleSize != 0 || bundle.size%bundleSize != 0 {
+			misaligned = append(misaligned, fmt.Sprintf("[%d, %d)", bundle.baseBlock, bundle.baseBlock+bundle.size))
+		}
+	}
+
+	if len(misaligned) == 0 {
+		return
+	}
+
+	fmt.Printf("Index files not aligned on the %d blocks bundle size (%d):\n", bundleSize, len(misaligned))
+	for _, rng := range misaligned {
+		fmt.Printf("  %s\n", rng)
+	}
+	fmt.Println()
+}
+
+func printTopIndexKeys(frequencies map[string]map[string]uint64, top int) {
+	kinds := make([]string, 0, len(frequencies))
+	for kind := range frequencies {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	for _, kind := range kinds {
+		values := frequencies[kind]
+
+		sorted := make([]string, 0, len(values))
+		for value := range values {
+			sorted = append(sorted, value)
+		}
+		sort.Slice(sorted, func(i, j int) bool {
+			if values[sorted[i]] == values[sorted[j]] {
+				return sorted[i] < sorted[j]
+			}
+			return values[sorted[i]] > values[sorted[j]]
+		})
+		if len(sorted) > top {
+			sorted = sorted[:top]
+		}
+
+		fmt.Printf("Top %s by blocks (%d distinct):\n", kind, len(values))
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, value := range sorted {
+			fmt.Fprintf(w, "  %s\t%d\n", value, values[value])
+		}
+		w.Flush()
+		fmt.Println()
+	}
+}
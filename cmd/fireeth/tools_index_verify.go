@@ -0,0 +1,298 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/bstream"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	"github.com/streamingfast/firehose-ethereum/transform"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// memoryIndex is a transform.Indexer keeping the bitmaps in memory
+type memoryIndex map[string]*roaring64.Bitmap
+
+func (m memoryIndex) Add(keys []string, blockNum uint64) {
+	for _, key := range keys {
+		if _, found := m[key]; !found {
+			m[key] = roaring64.NewBitmap()
+		}
+		m[key].Add(blockNum)
+	}
+}
+
+type blockRange struct {
+	start, stop uint64
+}
+
+type indexKeyMismatch struct {
+	key     string
+	missing []uint64
+	extra   []uint64
+}
+
+func createIndexVerifyE(logger *zap.Logger) firecore.CommandExecutor {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		rewrite := sflags.MustGetBool(cmd, "rewrite")
+		maxBlocks := sflags.MustGetInt(cmd, "max-reported-blocks")
+
+		indexStore, err := newIndexStore(args[0], rewrite)
+		if err != nil {
+			return err
+		}
+
+		mergedBlocksStore, err := dstore.NewDBinStore(args[1])
+		if err != nil {
+			return fmt.Errorf("unable to create merged blocks store: %w", err)
+		}
+
+		start := mustParseUint64(args[2])
+		stop := mustParseUint64(args[3])
+		if stop <= start {
+			return fmt.Errorf("stop block must be greater than start block")
+		}
+
+		bundleSize, err := bundleSizeFlag(cmd)
+		if err != nil {
+			return err
+		}
+
+		var verified, mismatched, rewritten int
+		var covered []blockRange
+		err = indexStore.Walk(ctx, "", func(filename string) error {
+			baseBlock, size, shortName, err := parseIndexFilename(filename)
+			if err != nil || shortName != transform.CombinedIndexerShortName {
+				logger.Debug("skipping file not being a combined index", zap.String("filename", filename))
+				return nil
+			}
+			if baseBlock >= stop {
+				return io.EOF
+			}
+			if baseBlock+size > start {
+				covered = append(covered, blockRange{baseBlock, baseBlock + size})
+			}
+			if baseBlock < start || baseBlock+size > stop {
+				logger.Debug("skipping index file not fully within range", zap.String("filename", filename))
+				return nil
+			}
+
+			expected, err := deriveIndex(ctx, mergedBlocksStore, baseBlock, size, bundleSize)
+			if err != nil {
+				return fmt.Errorf("deriving index %s from merged blocks: %w", filename, err)
+			}
+
+			index, _, err := readIndexFile(ctx, indexStore, filename)
+			if err != nil {
+				return err
+			}
+			stored := make(map[string]*roaring64.Bitmap, len(index.Kv))
+			for _, kv := range index.Kv {
+				bitmap := roaring64.NewBitmap()
+				if err := bitmap.UnmarshalBinary(kv.Bitmap); err != nil {
+					return fmt.Errorf("unmarshalling bitmap of key %q in %s: %w", kv.Key, filename, err)
+				}
+				stored[string(kv.Key)] = bitmap
+			}
+
+			verified++
+			mismatches := compareIndexes(expected, stored)
+			if len(mismatches) == 0 {
+				fmt.Printf("[%d, %d) OK\n", baseBlock, baseBlock+size)
+				return nil
+			}
+
+			mismatched++
+			fmt.Printf("[%d, %d) MISMATCH on %d keys\n", baseBlock, baseBlock+size, len(mismatches))
+			for _, mismatch := range mismatches {
+				kind, value := transform.IndexKeyKind(mismatch.key)
+				fmt.Printf("  %s %s: missing %s, extra %s\n", kind, value, blocksList(mismatch.missing, maxBlocks), blocksList(mismatch.extra, maxBlocks))
+			}
+
+			if rewrite {
+				if err := writeIndexFile(ctx, indexStore, filename, expected); err != nil {
+					return err
+				}
+				rewritten++
+				fmt.Printf("  rewrote %s\n", filename)
+			}
+			return nil
+		})
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("walking index store: %w", err)
+		}
+
+		missing := uncoveredRanges(blockRange{start, stop}, covered)
+		for _, rng := range missing {
+			fmt.Printf("[%d, %d) MISSING no index file covers this range\n", rng.start, rng.stop)
+		}
+
+		fmt.Printf("Verified %d index files, %d mismatching, %d rewritten, %d missing ranges\n", verified, mismatched, rewritten, len(missing))
+		if mismatched > rewritten || len(missing) > 0 {
+			return fmt.Errorf("%d index files don't match the merged blocks and %d ranges have no index file", mismatched-rewritten, len(missing))
+		}
+		return nil
+	}
+}
+
+// uncoveredRanges returns the parts of rng that none of the covered ranges contain
+func uncoveredRanges(rng blockRange, covered []blockRange) (out []blockRange) {
+	sorted := make([]blockRange, len(covered))
+	copy(sorted, covered)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].start < sorted[j].start })
+
+	coveredUntil := rng.start
+	for _, c := range sorted {
+		if c.start >= rng.stop {
+			break
+		}
+		if c.start > coveredUntil {
+			out = append(out, blockRange{coveredUntil, c.start})
+		}
+		coveredUntil = max(coveredUntil, c.stop)
+	}
+	if coveredUntil < rng.stop {
+		out = append(out, blockRange{coveredUntil, rng.stop})
+	}
+	return out
+}
+
+// deriveIndex builds the index of the [baseBlock, baseBlock+size) range from the merged blocks files of
+// bundleSize blocks, the way the index-builder does
+func deriveIndex(ctx context.Context, mergedBlocksStore dstore.Store, baseBlock, size, bundleSize uint64) (memoryIndex, error) {
+	out := memoryIndex{}
+	indexer := &transform.EthCombinedIndexer{BlockIndexer: out}
+
+	for bundle := baseBlock; bundle < baseBlock+size; bundle += bundleSize {
+		err := func() error {
+			reader, err := mergedBlocksStore.OpenObject(ctx, fmt.Sprintf("%010d", bundle))
+			if err != nil {
+				return fmt.Errorf("opening merged blocks file %010d: %w", bundle, err)
+			}
+			defer reader.Close()
+
+			blockReader, err := bstream.NewDBinBlockReader(reader)
+			if err != nil {
+				return fmt.Errorf("creating block reader: %w", err)
+			}
+
+			for {
+				block, err := blockReader.Read()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return fmt.Errorf("reading block: %w", err)
+				}
+
+				ethBlock := &pbeth.Block{}
+				if err := block.Payload.UnmarshalTo(ethBlock); err != nil {
+					return fmt.Errorf("unmarshaling eth block %d: %w", block.Number, err)
+				}
+				if err := indexer.ProcessBlock(ethBlock); err != nil {
+					return fmt.Errorf("indexing block %d: %w", block.Number, err)
+				}
+			}
+		}()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// compareIndexes returns the keys whose blocks differ between the expected and stored indexes, the keys of features
+// that the stored index was produced without (see transform.IndexKeyMarker) are not compared
+func compareIndexes(expected, stored map[string]*roaring64.Bitmap) (out []*indexKeyMismatch) {
+	keys := make(map[string]bool, len(expected))
+	for key := range expected {
+		keys[key] = true
+	}
+	for key := range stored {
+		keys[key] = true
+	}
+
+	for key := range keys {
+		if marker := transform.IndexKeyMarker(key); marker != "" && stored[marker] == nil {
+			continue
+		}
+
+		expectedBlocks, storedBlocks := roaring64.NewBitmap(), roaring64.NewBitmap()
+		if bitmap := expected[key]; bitmap != nil {
+			expectedBlocks = bitmap
+		}
+		if bitmap := stored[key]; bitmap != nil {
+			storedBlocks = bitmap
+		}
+
+		missing := roaring64.AndNot(expectedBlocks, storedBlocks)
+		extra := roaring64.AndNot(storedBlocks, expectedBlocks)
+		if missing.IsEmpty() && extra.IsEmpty() {
+			continue
+		}
+		out = append(out, &indexKeyMismatch{key: key, missing: missing.ToArray(), extra: extra.ToArray()})
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].key < out[j].key })
+	return out
+}
+
+func writeIndexFile(ctx context.Context, store dstore.Store, filename string, index memoryIndex) error {
+	keys := make([]string, 0, len(index))
+	for key := range index {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	out := &pbbstream.GenericBlockIndex{}
+	for _, key := range keys {
+		bitmap, err := index[key].ToBytes()
+		if err != nil {
+			return fmt.Errorf("marshalling bitmap of key %q: %w", key, err)
+		}
+		out.Kv = append(out.Kv, &pbbstream.KeyToBitmap{Key: []byte(key), Bitmap: bitmap})
+	}
+
+	data, err := proto.Marshal(out)
+	if err != nil {
+		return fmt.Errorf("marshalling index: %w", err)
+	}
+
+	if err := store.WriteObject(ctx, filename, bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("writing %s: %w", filename, err)
+	}
+	return nil
+}
+
+func blocksList(blocks []uint64, limit int) string {
+	if len(blocks) == 0 {
+		return "none"
+	}
+
+	shown := blocks
+	if len(shown) > limit {
+		shown = shown[:limit]
+	}
+
+	out := make([]string, len(shown))
+	for i, block := range shown {
+		out[i] = fmt.Sprintf("%d", block)
+	}
+	if len(blocks) > limit {
+		return fmt.Sprintf("%d blocks [%s, ...]", len(blocks), strings.Join(out, ", "))
+	}
+	return fmt.Sprintf("%d blocks [%s]", len(blocks), strings.Join(out, ", "))
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/firehose-ethereum/transform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareIndexes(t *testing.T) {
+	logAddress := transform.IdxPrefixLog + "a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+	callAddress := transform.IdxPrefixCall + "a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+	trxStatus := transform.IdxPrefixTrxStatus + "1"
+
+	expected := map[string]*roaring64.Bitmap{
+		logAddress:                     roaring64.BitmapOf(10, 11, 12),
+		callAddress:                    roaring64.BitmapOf(10),
+		trxStatus:                      roaring64.BitmapOf(10, 11, 12, 13),
+		transform.IdxKeyTransactions:   roaring64.BitmapOf(10, 11, 12, 13),
+		transform.IdxKeyStorageChanges: roaring64.BitmapOf(10, 11, 12, 13),
+	}
+
+	t.Run("identical", func(t *testing.T) {
+		assert.Empty(t, compareIndexes(expected, expected))
+	})
+
+	t.Run("missing and extra blocks", func(t *testing.T) {
+		stored := map[string]*roaring64.Bitmap{
+			logAddress:                     roaring64.BitmapOf(10, 12, 14),
+			trxStatus:                      roaring64.BitmapOf(10, 11, 12, 13),
+			transform.IdxKeyTransactions:   roaring64.BitmapOf(10, 11, 12, 13),
+			transform.IdxKeyStorageChanges: roaring64.BitmapOf(10, 11, 12, 13),
+		}
+
+		assert.Equal(t, []*indexKeyMismatch{
+			{key: callAddress, missing: []uint64{10}, extra: []uint64{}},
+			{key: logAddress, missing: []uint64{11}, extra: []uint64{14}},
+		}, compareIndexes(expected, stored))
+	})
+
+	t.Run("legacy index", func(t *testing.T) {
+		stored := map[string]*roaring64.Bitmap{
+			logAddress:  roaring64.BitmapOf(10, 11, 12),
+			callAddress: roaring64.BitmapOf(10),
+		}
+
+		assert.Empty(t, compareIndexes(expected, stored), "keys of features missing from the stored index should not be compared")
+	})
+}
+
+func TestUncoveredRanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		covered  []blockRange
+		expected []blockRange
+	}{
+		{"no index file", nil, []blockRange{{1000, 2000}}},
+		{"fully covered", []blockRange{{0, 10000}}, nil},
+		{"covered by several sizes", []blockRange{{1000, 1100}, {1000, 2000}, {1100, 1200}}, nil},
+		{"gaps", []blockRange{{1100, 1200}, {1500, 1600}}, []blockRange{{1000, 1100}, {1200, 1500}, {1600, 2000}}},
+		{"files past the range", []blockRange{{900, 1300}, {2000, 3000}}, []blockRange{{1300, 2000}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, uncoveredRanges(blockRange{1000, 2000}, test.covered))
+		})
+	}
+}
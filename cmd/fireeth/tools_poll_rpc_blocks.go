@@ -18,12 +18,15 @@ import (
 )
 
 func newPollRPCBlocksCmd(logger *zap.Logger) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "poll-rpc-blocks <rpc-endpoint> <start-block>",
 		Short: "Generate 'light' firehose blocks from an RPC endpoint",
 		Args:  cobra.ExactArgs(2),
 		RunE:  createPollRPCBlocksE(logger),
 	}
+	addFinalityFlags(cmd, 1)
+
+	return cmd
 }
 
 var pollDelay = time.Millisecond * 100
@@ -54,6 +57,11 @@ func createPollRPCBlocksE(logger *zap.Logger) firecore.CommandExecutor {
 		}
 		client := rpc.NewClient(rpcEndpoint)
 
+		finality, err := newFinalityProvider(cmd)
+		if err != nil {
+			return err
+		}
+
 		fmt.Println("FIRE INIT 2.3 local v1.0.0")
 
 		blockNum := startBlockNum
@@ -73,13 +81,13 @@ func createPollRPCBlocksE(logger *zap.Logger) firecore.CommandExecutor {
 				}
 			}
 
-			rpcBlock, err := client.GetBlockByNumber(ctx, rpc.BlockNumber(blockNum), rpc.WithGetBlockFullTransaction())
+			rpcBlock, err := blockfetcher.FetchBlock(ctx, client, blockNum)
 			if err != nil {
 				delay(err)
 				continue
 			}
 
-			receipts, err := blockfetcher.FetchReceipts(ctx, rpcBlock, client)
+			receipts, err := blockfetcher.FetchReceipts(ctx, rpcBlock.Block, client)
 			if err != nil {
 				delay(fmt.Errorf("fetching receipts for block %d %q: %w", rpcBlock.Number, rpcBlock.Hash.Pretty(), err))
 				continue
@@ -91,10 +99,12 @@ func createPollRPCBlocksE(logger *zap.Logger) firecore.CommandExecutor {
 				return fmt.Errorf("failed to proto  marshal pb sol block: %w", err)
 			}
 
-			libNum := uint64(0)
-			if blockNum != 0 {
-				libNum = blockNum - 1
+			libNum, err := finality.LIBNum(ctx, client, blockNum)
+			if err != nil {
+				delay(fmt.Errorf("resolving LIB of block %d: %w", blockNum, err))
+				continue
 			}
+
 			b64Cnt := base64.StdEncoding.EncodeToString(cnt)
 			lineCnt := fmt.Sprintf("FIRE BLOCK %d %s %d %s %s", blockNum, hex.EncodeToString(ethBlock.Hash), libNum, hex.EncodeToString(ethBlock.Header.ParentHash), b64Cnt)
 			if _, err := fmt.Println(lineCnt); err != nil {
@@ -1,23 +1,40 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/streamingfast/cli/sflags"
 	"github.com/streamingfast/eth-go"
 	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"gopkg.in/yaml.v3"
 )
 
 func parseTransformFlags(cmd *cobra.Command, logger *zap.Logger) (transforms []*anypb.Any, err error) {
-	filters, err := parseFilters(sflags.MustGetString(cmd, "call-filters"), sflags.MustGetString(cmd, "log-filters"), sflags.MustGetBool(cmd, "send-all-block-headers"))
+	filters, err := parseFilterFlags(cmd, sflags.MustGetBool(cmd, "send-all-block-headers"))
 	if err != nil {
 		return nil, err
 	}
 
+	excludeFailed := sflags.MustGetBool(cmd, "exclude-failed-transactions")
+	excludeReverted := sflags.MustGetBool(cmd, "exclude-reverted-calls")
+	if excludeFailed || excludeReverted {
+		if filters == nil || (len(filters.CallFilters) == 0 && len(filters.LogFilters) == 0) {
+			return nil, fmt.Errorf("'exclude-failed-transactions' and 'exclude-reverted-calls' flags require at least one of 'call-filters' or 'log-filters'")
+		}
+
+		filters.ExcludeFailedTransactions = excludeFailed
+		filters.ExcludeRevertedCalls = excludeReverted
+	}
+
 	headerOnly := sflags.MustGetBool(cmd, "header-only")
 	if filters != nil && headerOnly {
 		return nil, fmt.Errorf("'header-only' flag is exclusive with 'call-filters', 'log-filters' and 'send-all-block-headers' choose either 'header-only' or a combination of the others")
@@ -32,6 +49,22 @@ func parseTransformFlags(cmd *cobra.Command, logger *zap.Logger) (transforms []*
 		return []*anypb.Any{t}, nil
 	}
 
+	if sflags.MustGetBool(cmd, "trim") {
+		if filters == nil {
+			return nil, fmt.Errorf("'trim' flag requires at least one of 'call-filters', 'log-filters' or 'send-all-block-headers'")
+		}
+
+		t, err := anypb.New(&pbtransform.TrimmedFilter{
+			Filter: filters,
+			Keep:   &fieldmaskpb.FieldMask{Paths: sflags.MustGetStringSlice(cmd, "trim-keep")},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return []*anypb.Any{t}, nil
+	}
+
 	if filters != nil {
 		t, err := anypb.New(filters)
 		if err != nil {
@@ -44,72 +77,405 @@ func parseTransformFlags(cmd *cobra.Command, logger *zap.Logger) (transforms []*
 	return
 }
 
+// parseFilterFlags returns the filters defined by the 'call-filters', 'log-filters' and 'filters-file' flags,
+// nil if none of them is set and sendAllBlockHeaders is false
+func parseFilterFlags(cmd *cobra.Command, sendAllBlockHeaders bool) (*pbtransform.CombinedFilter, error) {
+	filters, err := parseFilters(sflags.MustGetString(cmd, "call-filters"), sflags.MustGetString(cmd, "log-filters"), sendAllBlockHeaders)
+	if err != nil {
+		return nil, err
+	}
+
+	if path := sflags.MustGetString(cmd, "filters-file"); path != "" {
+		fileFilters, err := readFiltersFile(path)
+		if err != nil {
+			return nil, err
+		}
+		filters = mergeFilters(filters, fileFilters)
+	}
+
+	return filters, nil
+}
+
 func parseFilters(callFilters, logFilters string, sendAllBlockHeaders bool) (*pbtransform.CombinedFilter, error) {
 	mf := &pbtransform.CombinedFilter{}
 
 	if callFilters == "" && logFilters == "" && !sendAllBlockHeaders {
 		return nil, nil
 	}
-	if callFilters != "" {
-		for _, filter := range strings.Split(callFilters, ",") {
-			if filter == "" {
-				continue
-			}
-			parts := strings.Split(filter, ":")
-			if len(parts) != 2 {
-				return nil, fmt.Errorf("option --call-filters must be of type address_hash+address_hash+address_hash:event_sig_hash+event_sig_hash (repeated, separated by comma)")
-			}
-			var addrs []eth.Address
-			for _, a := range strings.Split(parts[0], "+") {
-				if a != "" {
-					addr := eth.MustNewAddressLoose(a)
-					addrs = append(addrs, addr)
-				}
-			}
-			var sigs []eth.Hash
-			for _, s := range strings.Split(parts[1], "+") {
-				if s != "" {
-					sig := eth.MustNewHash(s)
-					sigs = append(sigs, sig)
-				}
+	for _, filter := range splitFilters(callFilters) {
+		parts := strings.Split(filter, ":")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, fmt.Errorf("option --call-filters must be of type address_hash+address_hash+address_hash:method_sig+method_sig[:caller_hash+caller_hash] (repeated, separated by comma or new line)")
+		}
+
+		var callers []string
+		if len(parts) == 3 {
+			callers = splitValues(parts[2], "+")
+		}
+
+		callFilter, err := newCallToFilter(splitValues(parts[0], "+"), splitValues(parts[1], "+"), callers)
+		if err != nil {
+			return nil, fmt.Errorf("invalid call filter %q: %w", filter, err)
+		}
+		mf.CallFilters = append(mf.CallFilters, callFilter)
+	}
+
+	for _, filter := range splitFilters(logFilters) {
+		parts := strings.Split(filter, ":")
+		if len(parts) < 2 || len(parts) > 5 {
+			return nil, fmt.Errorf("option --log-filters must be of type address_hash+address_hash+address_hash:event_sig+event_sig[:topic1_hash+topic1_hash[:topic2_hash[:topic3_hash]]] (repeated, separated by comma or new line)")
+		}
+
+		var topics [3][]string
+		for i, part := range parts[2:] {
+			topics[i] = splitValues(part, "+")
+		}
+
+		logFilter, err := newLogFilter(splitValues(parts[0], "+"), splitValues(parts[1], "+"), topics)
+		if err != nil {
+			return nil, fmt.Errorf("invalid log filter %q: %w", filter, err)
+		}
+		mf.LogFilters = append(mf.LogFilters, logFilter)
+	}
+
+	if sendAllBlockHeaders {
+		mf.SendAllBlockHeaders = true
+	}
+	return mf, nil
+}
+
+// filtersFile is the content of the file given to --filters-file, either in YAML or JSON
+type filtersFile struct {
+	CallFilters []struct {
+		Addresses  []string `yaml:"addresses"`
+		Signatures []string `yaml:"signatures"`
+		Callers    []string `yaml:"callers"`
+		MinDepth   uint32   `yaml:"min_depth"`
+		MaxDepth   *uint32  `yaml:"max_depth"`
+		CallTypes  []string `yaml:"call_types"`
+	} `yaml:"call_filters"`
+
+	LogFilters []struct {
+		Addresses       []string `yaml:"addresses"`
+		EventSignatures []string `yaml:"event_signatures"`
+		Topic1          []string `yaml:"topic1"`
+		Topic2          []string `yaml:"topic2"`
+		Topic3          []string `yaml:"topic3"`
+	} `yaml:"log_filters"`
+
+	SendAllBlockHeaders bool `yaml:"send_all_block_headers"`
+}
+
+// readFiltersFile reads the filters from the YAML or JSON file at path, returns nil when the
+// file doesn't define any filter.
+func readFiltersFile(path string) (*pbtransform.CombinedFilter, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read filters file: %w", err)
+	}
+
+	var in filtersFile
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&in); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("decode filters file %q: %w", path, err)
+	}
+
+	if len(in.CallFilters) == 0 && len(in.LogFilters) == 0 && !in.SendAllBlockHeaders {
+		return nil, nil
+	}
+
+	mf := &pbtransform.CombinedFilter{SendAllBlockHeaders: in.SendAllBlockHeaders}
+	for i, filter := range in.CallFilters {
+		callFilter, err := newCallToFilter(filter.Addresses, filter.Signatures, filter.Callers)
+		if err != nil {
+			return nil, fmt.Errorf("invalid call filter #%d of %q: %w", i, path, err)
+		}
+		callFilter.MinDepth = filter.MinDepth
+		callFilter.MaxDepth = filter.MaxDepth
+		if callFilter.CallTypes, err = parseCallTypes(filter.CallTypes); err != nil {
+			return nil, fmt.Errorf("invalid call filter #%d of %q: %w", i, path, err)
+		}
+		mf.CallFilters = append(mf.CallFilters, callFilter)
+	}
+	for i, filter := range in.LogFilters {
+		logFilter, err := newLogFilter(filter.Addresses, filter.EventSignatures, [3][]string{filter.Topic1, filter.Topic2, filter.Topic3})
+		if err != nil {
+			return nil, fmt.Errorf("invalid log filter #%d of %q: %w", i, path, err)
+		}
+		mf.LogFilters = append(mf.LogFilters, logFilter)
+	}
+	return mf, nil
+}
+
+// mergeFilters returns the union of the filters of a and b, any of them can be nil
+func mergeFilters(a, b *pbtransform.CombinedFilter) *pbtransform.CombinedFilter {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	return &pbtransform.CombinedFilter{
+		CallFilters:         append(a.CallFilters, b.CallFilters...),
+		LogFilters:          append(a.LogFilters, b.LogFilters...),
+		SendAllBlockHeaders: a.SendAllBlockHeaders || b.SendAllBlockHeaders,
+	}
+}
+
+func newCallToFilter(addresses, signatures, callers []string) (*pbtransform.CallToFilter, error) {
+	var addrs []eth.Address
+	for _, a := range addresses {
+		addr, err := eth.NewAddressLoose(a)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %w", a, err)
+		}
+		addrs = append(addrs, addr)
+	}
+
+	var sigs []eth.Hash
+	for _, s := range signatures {
+		sig, err := parseSignature(s, 4)
+		if err != nil {
+			return nil, fmt.Errorf("invalid method signature %q: %w", s, err)
+		}
+		sigs = append(sigs, sig)
+	}
+
+	callFilter := basicCallToFilter(addrs, sigs)
+	for _, c := range callers {
+		caller, err := eth.NewAddressLoose(c)
+		if err != nil {
+			return nil, fmt.Errorf("invalid caller %q: %w", c, err)
+		}
+		callFilter.Callers = append(callFilter.Callers, caller.Bytes())
+	}
+	return callFilter, nil
+}
+
+// parseCallTypes parses call type names like DELEGATE or STATIC, case insensitive
+func parseCallTypes(in []string) (out []pbeth.CallType, err error) {
+	for _, name := range in {
+		value, found := pbeth.CallType_value[strings.ToUpper(name)]
+		if !found {
+			return nil, fmt.Errorf("invalid call type %q", name)
+		}
+		out = append(out, pbeth.CallType(value))
+	}
+	return out, nil
+}
+
+func newLogFilter(addresses, eventSignatures []string, topics [3][]string) (*pbtransform.LogFilter, error) {
+	var addrs []eth.Address
+	for _, a := range addresses {
+		addr, err := eth.NewAddress(a)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %w", a, err)
+		}
+		addrs = append(addrs, addr)
+	}
+
+	var sigs []eth.Hash
+	for _, s := range eventSignatures {
+		sig, err := parseSignature(s, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid event signature %q: %w", s, err)
+		}
+		sigs = append(sigs, sig)
+	}
+
+	logFilter := basicLogFilter(addrs, sigs)
+	for i, values := range topics {
+		var topicsBytes [][]byte
+		for _, t := range values {
+			topic, err := parseTopic(t)
+			if err != nil {
+				return nil, fmt.Errorf("invalid topic%d %q: %w", i+1, t, err)
 			}
+			topicsBytes = append(topicsBytes, topic)
+		}
 
-			mf.CallFilters = append(mf.CallFilters, basicCallToFilter(addrs, sigs))
+		switch i {
+		case 0:
+			logFilter.Topic1 = topicsBytes
+		case 1:
+			logFilter.Topic2 = topicsBytes
+		case 2:
+			logFilter.Topic3 = topicsBytes
 		}
 	}
 
-	if logFilters != "" {
-		for _, filter := range strings.Split(logFilters, ",") {
-			if filter == "" {
-				continue
+	return logFilter, nil
+}
+
+// parseTopic accepts a 32 bytes hex value or an address, left-padded to 32 bytes like the indexed address
+// parameters of events are
+func parseTopic(in string) ([]byte, error) {
+	topic, err := eth.NewHash(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(topic) == 20 {
+		return append(make([]byte, 12), topic...), nil
+	}
+	return topic, nil
+}
+
+// parseSignature accepts either a hex encoded hash or a Solidity signature like `Transfer(address,address,uint256)`,
+// in which case the first size bytes of the Keccak-256 hash of its canonical form are returned (4 for a method
+// id, 32 for an event signature).
+func parseSignature(in string, size int) (eth.Hash, error) {
+	if !strings.Contains(in, "(") {
+		return eth.NewHash(in)
+	}
+
+	canonical, err := canonicalSignature(in)
+	if err != nil {
+		return nil, err
+	}
+	return eth.Keccak256([]byte(canonical))[0:size], nil
+}
+
+// canonicalSignature turns a Solidity signature into its canonical form, removing the parameter names, the
+// `indexed` keywords and the spaces, and expanding the `uint` and `int` aliases, for example
+// `Transfer(address indexed from, address indexed to, uint value)` becomes `Transfer(address,address,uint256)`.
+func canonicalSignature(in string) (string, error) {
+	in = strings.TrimSpace(in)
+	open := strings.Index(in, "(")
+	if open <= 0 || !strings.HasSuffix(in, ")") {
+		return "", fmt.Errorf("expected a signature of the form 'name(type1,type2,...)'")
+	}
+
+	name := strings.TrimSpace(in[:open])
+	params, err := canonicalParameters(in[open+1 : len(in)-1])
+	if err != nil {
+		return "", err
+	}
+	return name + "(" + params + ")", nil
+}
+
+func canonicalParameters(in string) (string, error) {
+	if strings.TrimSpace(in) == "" {
+		return "", nil
+	}
+
+	parts, err := splitTopLevel(in, ",")
+	if err != nil {
+		return "", err
+	}
+
+	out := make([]string, len(parts))
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return "", fmt.Errorf("empty parameter")
+		}
+
+		if strings.HasPrefix(part, "(") || strings.HasPrefix(part, "tuple(") {
+			part = strings.TrimPrefix(part, "tuple")
+			closing := matchingParenthesis(part)
+			if closing == -1 {
+				return "", fmt.Errorf("unbalanced parenthesis in %q", part)
 			}
-			parts := strings.Split(filter, ":")
-			if len(parts) != 2 {
-				return nil, fmt.Errorf("option --log-filters must be of type address_hash+address_hash+address_hash:event_sig_hash+event_sig_hash (repeated, separated by comma)")
+
+			inner, err := canonicalParameters(part[1:closing])
+			if err != nil {
+				return "", err
 			}
-			var addrs []eth.Address
-			for _, a := range strings.Split(parts[0], "+") {
-				if a != "" {
-					addr := eth.MustNewAddress(a)
-					addrs = append(addrs, addr)
-				}
+			rest := part[closing+1:]
+			arrays := rest[:len(rest)-len(strings.TrimLeft(rest, "[]0123456789"))]
+			out[i] = "(" + inner + ")" + arrays
+			continue
+		}
+
+		typeName, _, _ := strings.Cut(part, " ")
+		switch {
+		case typeName == "uint" || strings.HasPrefix(typeName, "uint["):
+			typeName = "uint256" + strings.TrimPrefix(typeName, "uint")
+		case typeName == "int" || strings.HasPrefix(typeName, "int["):
+			typeName = "int256" + strings.TrimPrefix(typeName, "int")
+		}
+		out[i] = typeName
+	}
+	return strings.Join(out, ","), nil
+}
+
+// splitFilters splits the filters on commas and new lines, except the ones within parenthesis so
+// that Solidity signatures can be used
+func splitFilters(in string) []string {
+	var out []string
+	depth, start := 0, 0
+	for i, c := range in {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',', '\n':
+			if depth == 0 {
+				out = appendNonEmpty(out, in[start:i])
+				start = i + 1
 			}
-			var sigs []eth.Hash
-			for _, s := range strings.Split(parts[1], "+") {
-				if s != "" {
-					sig := eth.MustNewHash(s)
-					sigs = append(sigs, sig)
-				}
+		}
+	}
+	return appendNonEmpty(out, in[start:])
+}
+
+// splitValues splits the values of a filter on sep, empty values are skipped
+func splitValues(in string, sep string) (out []string) {
+	for _, value := range strings.Split(in, sep) {
+		out = appendNonEmpty(out, value)
+	}
+	return out
+}
+
+func splitTopLevel(in string, sep string) ([]string, error) {
+	var out []string
+	depth, start := 0, 0
+	for i, c := range in {
+		switch {
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced parenthesis in %q", in)
 			}
+		case depth == 0 && strings.HasPrefix(in[i:], sep):
+			out = append(out, in[start:i])
+			start = i + len(sep)
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced parenthesis in %q", in)
+	}
+	return append(out, in[start:]), nil
+}
 
-			mf.LogFilters = append(mf.LogFilters, basicLogFilter(addrs, sigs))
+// matchingParenthesis returns the index of the parenthesis closing the one at the start of in, -1 if none
+func matchingParenthesis(in string) int {
+	depth := 0
+	for i, c := range in {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
 		}
 	}
+	return -1
+}
 
-	if sendAllBlockHeaders {
-		mf.SendAllBlockHeaders = true
+func appendNonEmpty(out []string, value string) []string {
+	if value = strings.TrimSpace(value); value != "" {
+		return append(out, value)
 	}
-	return mf, nil
+	return out
 }
 
 func basicCallToFilter(addrs []eth.Address, sigs []eth.Hash) *pbtransform.CallToFilter {
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	transferEventSig  = eth.MustNewHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef").Bytes()
+	transferMethodSig = eth.MustNewHex("0xa9059cbb").Bytes()
+	usdcAddress       = eth.MustNewAddress("0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48").Bytes()
+	wethAddress       = eth.MustNewAddress("0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2").Bytes()
+)
+
+func TestCanonicalSignature(t *testing.T) {
+	tests := []struct {
+		in          string
+		expected    string
+		expectedErr bool
+	}{
+		{"Transfer(address,address,uint256)", "Transfer(address,address,uint256)", false},
+		{"Transfer(address indexed from, address indexed to, uint value)", "Transfer(address,address,uint256)", false},
+		{"swap((address,bool) order, int[] amounts)", "swap((address,bool),int256[])", false},
+		{"fill(tuple(address maker,(uint256,bytes)[])[] orders)", "fill((address,(uint256,bytes)[])[])", false},
+		{"pause()", "pause()", false},
+		{"Transfer(address", "", true},
+		{"(address)", "", true},
+		{"swap((address,bool)", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			out, err := canonicalSignature(test.in)
+			if test.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, out)
+		})
+	}
+}
+
+func TestParseFilters(t *testing.T) {
+	filters, err := parseFilters(
+		"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48:transfer(address,uint256)",
+		"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48:Transfer(address indexed from, address indexed to, uint256 value)\n0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2:0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+		false,
+	)
+	require.NoError(t, err)
+
+	assertProtoEqual(t, &pbtransform.CombinedFilter{
+		CallFilters: []*pbtransform.CallToFilter{{Addresses: [][]byte{usdcAddress}, Signatures: [][]byte{transferMethodSig}}},
+		LogFilters: []*pbtransform.LogFilter{
+			{Addresses: [][]byte{usdcAddress}, EventSignatures: [][]byte{transferEventSig}},
+			{Addresses: [][]byte{wethAddress}, EventSignatures: [][]byte{transferEventSig}},
+		},
+	}, filters)
+
+	_, err = parseFilters("", "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48:Transfer(address", false)
+	require.Error(t, err)
+
+	filters, err = parseFilters(":transfer(address,uint256):0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2", "", false)
+	require.NoError(t, err)
+	assertProtoEqual(t, &pbtransform.CombinedFilter{
+		CallFilters: []*pbtransform.CallToFilter{{Signatures: [][]byte{transferMethodSig}, Callers: [][]byte{wethAddress}}},
+	}, filters)
+
+	_, err = parseFilters("0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48::0xc02a:extra", "", false)
+	require.Error(t, err)
+}
+
+func TestParseFilters_LogTopics(t *testing.T) {
+	wallet := eth.MustNewAddress("0x28c6c06298d514db089934071355e5743bf21d60")
+	paddedWallet := append(make([]byte, 12), wallet...)
+	amount := eth.MustNewHash("0x00000000000000000000000000000000000000000000000000000000000f4240").Bytes()
+
+	filters, err := parseFilters("", "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48:0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef::"+wallet.Pretty()+":"+eth.Hash(amount).Pretty(), false)
+	require.NoError(t, err)
+
+	assertProtoEqual(t, &pbtransform.CombinedFilter{
+		LogFilters: []*pbtransform.LogFilter{
+			{Addresses: [][]byte{usdcAddress}, EventSignatures: [][]byte{transferEventSig}, Topic2: [][]byte{paddedWallet}, Topic3: [][]byte{amount}},
+		},
+	}, filters)
+}
+
+func TestReadFiltersFile(t *testing.T) {
+	expected := &pbtransform.CombinedFilter{
+		CallFilters: []*pbtransform.CallToFilter{{Addresses: [][]byte{usdcAddress}, Signatures: [][]byte{transferMethodSig}}},
+		LogFilters:  []*pbtransform.LogFilter{{EventSignatures: [][]byte{transferEventSig}, Topic2: [][]byte{eth.MustNewHash("0x000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2").Bytes()}}},
+	}
+
+	files := map[string]string{
+		"filters.yaml": `
+call_filters:
+  - addresses: [0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48]
+    signatures: ["transfer(address to, uint256 amount)"]
+log_filters:
+  - event_signatures: ["Transfer(address,address,uint256)"]
+    topic2: ["0x000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2"]
+`,
+		"filters.json": `{
+  "call_filters": [{"addresses": ["0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"], "signatures": ["0xa9059cbb"]}],
+  "log_filters": [{"event_signatures": ["Transfer(address,address,uint256)"], "topic2": ["0x000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2"]}]
+}`,
+	}
+
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+
+			filters, err := readFiltersFile(path)
+			require.NoError(t, err)
+			assertProtoEqual(t, expected, filters)
+		})
+	}
+
+	t.Run("call constraints", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "filters.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(`
+call_filters:
+  - addresses: [0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48]
+    max_depth: 0
+    call_types: [call, DELEGATE]
+`), 0644))
+
+		filters, err := readFiltersFile(path)
+		require.NoError(t, err)
+
+		maxDepth := uint32(0)
+		assertProtoEqual(t, &pbtransform.CombinedFilter{
+			CallFilters: []*pbtransform.CallToFilter{{Addresses: [][]byte{usdcAddress}, MaxDepth: &maxDepth, CallTypes: []pbeth.CallType{pbeth.CallType_CALL, pbeth.CallType_DELEGATE}}},
+		}, filters)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "filters.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("call_filter: []\n"), 0644))
+
+		_, err := readFiltersFile(path)
+		require.Error(t, err)
+	})
+}
+
+func assertProtoEqual(t *testing.T, expected, actual proto.Message) {
+	t.Helper()
+	assert.True(t, proto.Equal(expected, actual), "expected %v, got %v", expected, actual)
+}
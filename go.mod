@@ -38,6 +38,7 @@ require (
 	go.uber.org/zap v1.26.0
 	google.golang.org/grpc v1.70.0
 	google.golang.org/protobuf v1.36.4
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -211,7 +212,6 @@ require (
 	gopkg.in/ini.v1 v1.67.0 // indirect
 	gopkg.in/olivere/elastic.v3 v3.0.75 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 	k8s.io/api v0.25.0 // indirect
 	k8s.io/apimachinery v0.25.0 // indirect
 	k8s.io/client-go v0.25.0 // indirect
@@ -224,6 +224,11 @@ require (
 )
 
 replace (
+	// TODO: the types module carries the protobuf changes of the unreleased features, this replace breaks
+	// `go install github.com/streamingfast/firehose-ethereum/cmd/fireeth@<version>` and must be removed before
+	// tagging a release, once the types module is tagged and the `require` above bumped to that version
+	github.com/streamingfast/firehose-ethereum/types => ./types
+
 	cloud.google.com/go => github.com/streamingfast/google-cloud-go v0.0.0-20241202194114-f77ff78d4f66
 	github.com/ShinyTrinkets/overseer => github.com/streamingfast/overseer v0.2.1-0.20210326144022-ee491780e3ef
 	github.com/bytecodealliance/wasmtime-go/v4 => github.com/streamingfast/wasmtime-go/v4 v4.0.0-freemem3
@@ -0,0 +1,114 @@
+package transform
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/eth-go"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+)
+
+type BalanceChangeFilter struct {
+	addresses []eth.Address
+	reasons   []pbeth.BalanceChange_Reason
+}
+
+func NewBalanceChangeFilter(in *pbtransform.BalanceChangeFilter) (*BalanceChangeFilter, error) {
+	if len(in.Addresses) == 0 && len(in.Reasons) == 0 {
+		return nil, fmt.Errorf("a balance change filter transform requires at-least one address or one reason")
+	}
+
+	f := &BalanceChangeFilter{
+		addresses: make([]eth.Address, len(in.Addresses)),
+		reasons:   in.Reasons,
+	}
+	for i, addr := range in.Addresses {
+		f.addresses[i] = addr
+	}
+	return f, nil
+}
+
+func (f *BalanceChangeFilter) String() string {
+	addresses := make([]string, len(f.addresses))
+	for i, addr := range f.addresses {
+		addresses[i] = addr.Pretty()
+	}
+
+	return fmt.Sprintf("{addrs: %s, reasons: %v}", strings.Join(addresses, ","), f.reasons)
+}
+
+func (f *BalanceChangeFilter) matchReason(reason pbeth.BalanceChange_Reason) bool {
+	if len(f.reasons) == 0 {
+		return true
+	}
+	for _, r := range f.reasons {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *BalanceChangeFilter) matchBalanceChange(change *pbeth.BalanceChange) bool {
+	return matchAnyAddress(f.addresses, change.Address) && f.matchReason(change.Reason)
+}
+
+func (f *BalanceChangeFilter) matches(trace *pbeth.TransactionTrace) bool {
+	for _, call := range trace.Calls {
+		for _, change := range call.BalanceChanges {
+			if f.matchBalanceChange(change) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (f *BalanceChangeFilter) indexMarker() string {
+	return IdxKeyBalanceChanges
+}
+
+// bitmap finds the blockNums with a balance change on one of the addresses (if any) and for
+// one of the reasons (if any)
+func (f *BalanceChangeFilter) bitmap(bitmaps transform.BitmapGetter) *roaring64.Bitmap {
+	switch {
+	case len(f.addresses) != 0 && len(f.reasons) == 0:
+		return addressBitmap(f.addresses, bitmaps, IdxPrefixBalance)
+	case len(f.reasons) != 0 && len(f.addresses) == 0:
+		return enumBitmap(f.reasons, bitmaps, IdxPrefixBalanceReason)
+	default:
+		out := addressBitmap(f.addresses, bitmaps, IdxPrefixBalance)
+		out.And(enumBitmap(f.reasons, bitmaps, IdxPrefixBalanceReason))
+		return out
+	}
+}
+
+func isBalanceChangeFilter(leaf traceFilter) bool {
+	_, ok := leaf.(*BalanceChangeFilter)
+	return ok
+}
+
+func balanceChangeKeys(changes []*pbeth.BalanceChange) map[string]bool {
+	out := make(map[string]bool)
+	for _, change := range changes {
+		out[IdxPrefixBalance+hex.EncodeToString(change.Address)] = true
+		out[IdxPrefixBalanceReason+strconv.FormatInt(int64(change.Reason), 10)] = true
+	}
+	return out
+}
+
+// callsBalanceChangeKeys returns the keys of the balance changes of the calls of the transaction
+func callsBalanceChangeKeys(trace *pbeth.TransactionTrace) map[string]bool {
+	out := make(map[string]bool)
+	for _, call := range trace.Calls {
+		for key := range balanceChangeKeys(call.BalanceChanges) {
+			out[key] = true
+		}
+	}
+	return out
+}
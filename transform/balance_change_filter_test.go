@@ -0,0 +1,40 @@
+package transform
+
+import (
+	"testing"
+
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+)
+
+func TestBalanceChangeFilter(t *testing.T) {
+	trxWithBalanceChange := func(change *pbeth.BalanceChange) *pbeth.TransactionTrace {
+		return &pbeth.TransactionTrace{
+			Receipt: &pbeth.TransactionReceipt{},
+			Calls:   []*pbeth.Call{{BalanceChanges: []*pbeth.BalanceChange{change}}},
+		}
+	}
+
+	gasRefund := &pbeth.BalanceChange{Address: senderA, Reason: pbeth.BalanceChange_REASON_GAS_REFUND}
+	transfer := &pbeth.BalanceChange{Address: senderB, Reason: pbeth.BalanceChange_REASON_TRANSFER}
+
+	blocks := []*pbeth.Block{
+		{Number: 10, TransactionTraces: []*pbeth.TransactionTrace{trxWithBalanceChange(gasRefund)}},
+		{Number: 11, TransactionTraces: []*pbeth.TransactionTrace{trxWithBalanceChange(transfer)}},
+		{Number: 12, BalanceChanges: []*pbeth.BalanceChange{
+			{Address: senderA, Reason: pbeth.BalanceChange_REASON_WITHDRAWAL},
+			{Address: recipient, Reason: pbeth.BalanceChange_REASON_REWARD_MINE_BLOCK},
+		}},
+	}
+	testFilterKind(t, blocks, IdxKeyBalanceChanges, func(filter *pbtransform.BalanceChangeFilter) *pbtransform.CombinedFilter {
+		return &pbtransform.CombinedFilter{BalanceChangeFilters: []*pbtransform.BalanceChangeFilter{filter}}
+	}, []filterKindTest[*pbtransform.BalanceChangeFilter]{
+		{"address", &pbtransform.BalanceChangeFilter{Addresses: [][]byte{senderA}}, []uint64{10}, []uint64{10, 12}},
+		{"reason", &pbtransform.BalanceChangeFilter{Reasons: []pbeth.BalanceChange_Reason{pbeth.BalanceChange_REASON_TRANSFER}}, []uint64{11}, []uint64{11}},
+		{"block level reason", &pbtransform.BalanceChangeFilter{Reasons: []pbeth.BalanceChange_Reason{pbeth.BalanceChange_REASON_WITHDRAWAL}}, nil, []uint64{12}},
+		{"address and reason", &pbtransform.BalanceChangeFilter{
+			Addresses: [][]byte{senderA},
+			Reasons:   []pbeth.BalanceChange_Reason{pbeth.BalanceChange_REASON_GAS_REFUND, pbeth.BalanceChange_REASON_TRANSFER},
+		}, []uint64{10}, []uint64{10}},
+	})
+}
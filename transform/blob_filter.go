@@ -0,0 +1,96 @@
+package transform
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/eth-go"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+)
+
+type BlobFilter struct {
+	from       []eth.Address
+	blobHashes []eth.Hash
+}
+
+func NewBlobFilter(in *pbtransform.BlobFilter) (*BlobFilter, error) {
+	f := &BlobFilter{
+		from:       make([]eth.Address, len(in.From)),
+		blobHashes: make([]eth.Hash, len(in.BlobHashes)),
+	}
+	for i, addr := range in.From {
+		f.from[i] = addr
+	}
+	for i, hash := range in.BlobHashes {
+		if len(hash) != 32 {
+			return nil, fmt.Errorf("invalid blob hash %x: expected 32 bytes, got %d", hash, len(hash))
+		}
+		f.blobHashes[i] = hash
+	}
+	return f, nil
+}
+
+func (f *BlobFilter) String() string {
+	from := make([]string, len(f.from))
+	for i, addr := range f.from {
+		from[i] = addr.Pretty()
+	}
+	blobHashes := make([]string, len(f.blobHashes))
+	for i, hash := range f.blobHashes {
+		blobHashes[i] = hash.Pretty()
+	}
+
+	return fmt.Sprintf("{from: %s, blob_hashes: %s}", strings.Join(from, ","), strings.Join(blobHashes, ","))
+}
+
+func (f *BlobFilter) matches(trace *pbeth.TransactionTrace) bool {
+	if len(trace.BlobHashes) == 0 || !matchAnyAddress(f.from, trace.From) {
+		return false
+	}
+
+	for _, hash := range trace.BlobHashes {
+		if matchAnyHash(f.blobHashes, hash) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *BlobFilter) indexMarker() string {
+	return IdxKeyBlobs
+}
+
+// bitmap finds the blockNums containing blob transactions matching each of the provided
+// constraints, or all blob transactions if the filter has no constraint
+func (f *BlobFilter) bitmap(bitmaps transform.BitmapGetter) *roaring64.Bitmap {
+	out := roaring64.NewBitmap()
+	if bm := bitmaps.Get(IdxKeyHasBlobTransaction); bm != nil {
+		out.Or(bm)
+	}
+
+	if len(f.from) != 0 {
+		out.And(addressBitmap(f.from, bitmaps, IdxPrefixBlobSender))
+	}
+	if len(f.blobHashes) != 0 {
+		out.And(sigsBitmap(f.blobHashes, bitmaps, IdxPrefixBlobHash))
+	}
+	return out
+}
+
+func blobKeys(trace *pbeth.TransactionTrace) map[string]bool {
+	out := make(map[string]bool)
+	if len(trace.BlobHashes) == 0 {
+		return out
+	}
+
+	out[IdxKeyHasBlobTransaction] = true
+	out[IdxPrefixBlobSender+hex.EncodeToString(trace.From)] = true
+	for _, hash := range trace.BlobHashes {
+		out[IdxPrefixBlobHash+hex.EncodeToString(hash)] = true
+	}
+	return out
+}
@@ -0,0 +1,44 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobFilter(t *testing.T) {
+	blobA := eth.MustNewHash("0x0111111111111111111111111111111111111111111111111111111111111111")
+	blobB := eth.MustNewHash("0x0122222222222222222222222222222222222222222222222222222222222222")
+	blobC := eth.MustNewHash("0x0133333333333333333333333333333333333333333333333333333333333333")
+
+	blobTrx := func(from eth.Address, hashes ...eth.Hash) *pbeth.TransactionTrace {
+		trace := &pbeth.TransactionTrace{From: from, Type: pbeth.TransactionTrace_TRX_TYPE_BLOB, Receipt: &pbeth.TransactionReceipt{}}
+		for _, hash := range hashes {
+			trace.BlobHashes = append(trace.BlobHashes, hash)
+		}
+		return trace
+	}
+
+	blocks := []*pbeth.Block{
+		{Number: 10, TransactionTraces: []*pbeth.TransactionTrace{blobTrx(senderA, blobA, blobB)}},
+		{Number: 11, TransactionTraces: []*pbeth.TransactionTrace{blobTrx(senderB, blobC)}},
+		{Number: 12, TransactionTraces: []*pbeth.TransactionTrace{{From: senderA, Receipt: &pbeth.TransactionReceipt{}}}},
+	}
+	testFilterKind(t, blocks, IdxKeyBlobs, func(filter *pbtransform.BlobFilter) *pbtransform.CombinedFilter {
+		return &pbtransform.CombinedFilter{BlobFilters: []*pbtransform.BlobFilter{filter}}
+	}, []filterKindTest[*pbtransform.BlobFilter]{
+		{"any blob transaction", &pbtransform.BlobFilter{}, []uint64{10, 11}, []uint64{10, 11}},
+		{"from", &pbtransform.BlobFilter{From: [][]byte{senderA}}, []uint64{10}, []uint64{10}},
+		{"blob hash", &pbtransform.BlobFilter{BlobHashes: [][]byte{blobB, blobC}}, []uint64{10, 11}, []uint64{10, 11}},
+		{"from and blob hash", &pbtransform.BlobFilter{From: [][]byte{senderB}, BlobHashes: [][]byte{blobC}}, []uint64{11}, []uint64{11}},
+		{"no match", &pbtransform.BlobFilter{From: [][]byte{senderA}, BlobHashes: [][]byte{blobC}}, nil, nil},
+	})
+}
+
+func TestBlobFilter_InvalidHash(t *testing.T) {
+	_, err := NewBlobFilter(&pbtransform.BlobFilter{BlobHashes: [][]byte{{0x01, 0x02}}})
+	require.Error(t, err)
+}
@@ -2,8 +2,11 @@ package transform
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
+	"strconv"
 
+	"github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/streamingfast/bstream/transform"
 	"github.com/streamingfast/dstore"
 	"github.com/streamingfast/eth-go"
@@ -18,6 +21,15 @@ var MultiCallToFilterMessageName = proto.MessageName(&pbtransform.MultiCallToFil
 type CallToFilter struct {
 	addresses  []eth.Address
 	signatures []eth.Hash
+
+	// minDepth and maxDepth bound the depth of the matched calls, maxDepth is nil when there is no upper bound
+	minDepth  uint32
+	maxDepth  *uint32
+	callTypes []pbeth.CallType
+	callers   []eth.Address
+
+	// ignoreReverted skips the calls that have been reverted
+	ignoreReverted bool
 }
 
 func (f *CallToFilter) Addresses() []eth.Address {
@@ -28,14 +40,25 @@ func (f *CallToFilter) Signatures() []eth.Hash {
 	return f.signatures
 }
 
+// topLevelOnly returns true when the filter only matches the top-level calls (depth 0) of the transactions
+func (f *CallToFilter) topLevelOnly() bool {
+	return f.maxDepth != nil && *f.maxDepth == 0
+}
+
 func NewCallToFilter(in *pbtransform.CallToFilter) (*CallToFilter, error) {
-	if len(in.Addresses) == 0 && len(in.Signatures) == 0 {
-		return nil, fmt.Errorf("a call filter transform requires at-least one address or one method signature")
+	if len(in.Addresses) == 0 && len(in.Signatures) == 0 && len(in.Callers) == 0 {
+		return nil, fmt.Errorf("a call filter transform requires at-least one address, one method signature or one caller")
+	}
+	if in.MaxDepth != nil && *in.MaxDepth < in.MinDepth {
+		return nil, fmt.Errorf("invalid call filter depth bounds: max depth %d is lower than min depth %d", *in.MaxDepth, in.MinDepth)
 	}
 
 	f := &CallToFilter{
 		addresses:  make([]eth.Address, 0, len(in.Addresses)),
 		signatures: make([]eth.Hash, 0, len(in.Signatures)),
+		minDepth:   in.MinDepth,
+		maxDepth:   in.MaxDepth,
+		callTypes:  in.CallTypes,
 	}
 	for _, addr := range in.Addresses {
 		f.addresses = append(f.addresses, addr)
@@ -43,6 +66,9 @@ func NewCallToFilter(in *pbtransform.CallToFilter) (*CallToFilter, error) {
 	for _, sig := range in.Signatures {
 		f.signatures = append(f.signatures, sig)
 	}
+	for _, caller := range in.Callers {
+		f.callers = append(f.callers, caller)
+	}
 
 	return f, nil
 
@@ -72,8 +98,30 @@ func (p *CallToFilter) matchSignature(src eth.Hash) bool {
 	return false
 }
 
+func (p *CallToFilter) matchDepth(depth uint32) bool {
+	return depth >= p.minDepth && (p.maxDepth == nil || depth <= *p.maxDepth)
+}
+
+func (p *CallToFilter) matchCallType(callType pbeth.CallType) bool {
+	if len(p.callTypes) == 0 {
+		return true
+	}
+	for _, accepted := range p.callTypes {
+		if accepted == callType {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *CallToFilter) matches(trace *pbeth.TransactionTrace) bool {
 	for _, call := range trace.Calls {
+		if p.ignoreReverted && call.StateReverted {
+			continue
+		}
+		if !p.matchDepth(call.Depth) || !p.matchCallType(call.CallType) || !matchAnyAddress(p.callers, call.Caller) {
+			continue
+		}
 		if p.matchAddress(call.Address) && p.matchSignature(call.Method()) {
 			return true
 		}
@@ -81,6 +129,96 @@ func (p *CallToFilter) matches(trace *pbeth.TransactionTrace) bool {
 	return false
 }
 
+func (f *CallToFilter) indexMarker() string {
+	// index files produced before call depths, types and callers were indexed are used for addresses and signatures
+	return ""
+}
+
+// callTypeSeparator ends the call type number of the keys under IdxPrefixCallType
+const callTypeSeparator = ":"
+
+// callTypePrefix returns the index prefix used for the calls of the given type, `CY<type>:`
+func callTypePrefix(callType pbeth.CallType) string {
+	return IdxPrefixCallType + strconv.FormatInt(int64(callType), 10) + callTypeSeparator
+}
+
+// callDetailKeys returns the keys of the top-level call and of the calls by type, each of them indexes both
+// the call address and method signature like callKeys
+func callDetailKeys(trace *pbeth.TransactionTrace) map[string]bool {
+	out := make(map[string]bool)
+	add := func(prefix string, call *pbeth.Call) {
+		out[prefix+hex.EncodeToString(call.Address)] = true
+		if sig := call.Method(); sig != nil {
+			out[prefix+hex.EncodeToString(sig)] = true
+		}
+	}
+
+	for _, call := range trace.Calls {
+		if call.Depth == 0 {
+			add(IdxPrefixCallTopLevel, call)
+		}
+		add(callTypePrefix(call.CallType), call)
+	}
+	return out
+}
+
+// bitmap finds the blockNums matching the provided CallToFilter, narrowing down the addresses/signatures
+// results on the top-level calls, call types and callers when the index contains them. Only the top-level
+// calls are indexed by depth, any other depth bounds (min_depth > 0 or max_depth > 0) don't narrow down the
+// blocks and are only applied when filtering the transactions.
+func (f *CallToFilter) bitmap(bitmaps transform.BitmapGetter) *roaring64.Bitmap {
+	var out *roaring64.Bitmap
+	if len(f.addresses) != 0 || len(f.signatures) != 0 {
+		out = f.addressSignatureBitmap(bitmaps)
+	}
+
+	if len(f.callers) == 0 {
+		return out
+	}
+
+	if bitmaps.Get(IdxKeyCallCallers) == nil {
+		// index produced before callers were indexed, we can only rely on the addresses/signatures, or
+		// on the fact that the block contains at least one call when the filter has only callers
+		if out == nil {
+			out = roaring64.NewBitmap()
+			if bm := bitmaps.GetByPrefixAndSuffix(IdxPrefixCall, ""); bm != nil {
+				out.Or(bm)
+			}
+		}
+		return out
+	}
+
+	callers := addressBitmap(f.callers, bitmaps, callCallerPrefix(IdxPrefixCall))
+	if out == nil {
+		return callers
+	}
+	out.And(callers)
+	return out
+}
+
+func (f *CallToFilter) addressSignatureBitmap(bitmaps transform.BitmapGetter) *roaring64.Bitmap {
+	if bitmaps.Get(IdxKeyCallDepthsAndTypes) == nil {
+		// index produced before call depths and types were indexed
+		return filterBitmap(f, bitmaps, IdxPrefixCall)
+	}
+
+	var out *roaring64.Bitmap
+	if f.topLevelOnly() {
+		out = filterBitmap(f, bitmaps, IdxPrefixCallTopLevel)
+	} else {
+		out = filterBitmap(f, bitmaps, IdxPrefixCall)
+	}
+
+	if len(f.callTypes) != 0 {
+		types := roaring64.NewBitmap()
+		for _, callType := range f.callTypes {
+			types.Or(filterBitmap(f, bitmaps, callTypePrefix(callType)))
+		}
+		out.And(types)
+	}
+	return out
+}
+
 func NewMultiCallToFilterTransformFactory(indexStore dstore.Store, possibleIndexSizes []uint64) (*transform.Factory, error) {
 	return MultiCallToFilterTransformFactory(indexStore, possibleIndexSizes), nil
 }
@@ -101,7 +239,7 @@ func MultiCallToFilterTransformFactory(indexStore dstore.Store, possibleIndexSiz
 				return nil, fmt.Errorf("unexpected unmarshall error: %w", err)
 			}
 
-			return newCombinedFilter(filter.CallFilters, nil, indexStore, possibleIndexSizes, false)
+			return newCombinedFilter(&pbtransform.CombinedFilter{CallFilters: filter.CallFilters}, indexStore, possibleIndexSizes)
 		},
 	}
 }
@@ -0,0 +1,161 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallToFilter_DepthAndCallTypes(t *testing.T) {
+	proxy := eth.MustNewAddress("0x1111111111111111111111111111111111111111")
+	implementation := eth.MustNewAddress("0x2222222222222222222222222222222222222222")
+
+	calls := func(calls ...*pbeth.Call) *pbeth.TransactionTrace {
+		return &pbeth.TransactionTrace{Receipt: &pbeth.TransactionReceipt{}, Calls: calls}
+	}
+
+	blocks := []*pbeth.Block{
+		// top-level call to the proxy delegating to the implementation
+		{Number: 10, TransactionTraces: []*pbeth.TransactionTrace{calls(
+			&pbeth.Call{CallType: pbeth.CallType_CALL, Address: proxy, Depth: 0},
+			&pbeth.Call{CallType: pbeth.CallType_DELEGATE, Address: implementation, Depth: 1},
+		)}},
+		// internal call to the proxy delegating to the implementation
+		{Number: 11, TransactionTraces: []*pbeth.TransactionTrace{calls(
+			&pbeth.Call{CallType: pbeth.CallType_CALL, Address: recipient, Depth: 0},
+			&pbeth.Call{CallType: pbeth.CallType_STATIC, Address: proxy, Depth: 1},
+			&pbeth.Call{CallType: pbeth.CallType_DELEGATE, Address: implementation, Depth: 2},
+		)}},
+		// direct call to the implementation
+		{Number: 12, TransactionTraces: []*pbeth.TransactionTrace{calls(
+			&pbeth.Call{CallType: pbeth.CallType_CALL, Address: implementation, Depth: 0},
+		)}},
+	}
+	bitmaps := indexBlocks(blocks...)
+
+	depth := func(depth uint32) *uint32 { return &depth }
+
+	tests := []struct {
+		name          string
+		filter        *pbtransform.CallToFilter
+		expectTrace   []uint64
+		expectIndexed []uint64
+	}{
+		{"any depth", &pbtransform.CallToFilter{Addresses: [][]byte{proxy}}, []uint64{10, 11}, []uint64{10, 11}},
+		{"top-level only", &pbtransform.CallToFilter{Addresses: [][]byte{proxy}, MaxDepth: depth(0)}, []uint64{10}, []uint64{10}},
+		{"internal only", &pbtransform.CallToFilter{Addresses: [][]byte{proxy}, MinDepth: 1}, []uint64{11}, []uint64{10, 11}},
+		{"depth range", &pbtransform.CallToFilter{Addresses: [][]byte{implementation}, MinDepth: 1, MaxDepth: depth(1)}, []uint64{10}, []uint64{10, 11, 12}},
+		{"delegate calls", &pbtransform.CallToFilter{Addresses: [][]byte{implementation}, CallTypes: []pbeth.CallType{pbeth.CallType_DELEGATE}}, []uint64{10, 11}, []uint64{10, 11}},
+		{"static calls", &pbtransform.CallToFilter{Addresses: [][]byte{proxy}, CallTypes: []pbeth.CallType{pbeth.CallType_STATIC}}, []uint64{11}, []uint64{11}},
+		{"top-level delegate calls", &pbtransform.CallToFilter{Addresses: [][]byte{implementation}, MaxDepth: depth(0), CallTypes: []pbeth.CallType{pbeth.CallType_DELEGATE}}, nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := newCombinedFilter(&pbtransform.CombinedFilter{CallFilters: []*pbtransform.CallToFilter{test.filter}}, nil, nil)
+			require.NoError(t, err)
+
+			var matching []uint64
+			for _, blk := range blocks {
+				if f.matches(blk.TransactionTraces[0]) {
+					matching = append(matching, blk.Number)
+				}
+			}
+			assert.Equal(t, test.expectTrace, matching)
+
+			out, supported := f.indexedBlocks(bitmaps)
+			require.True(t, supported)
+			assert.Equal(t, test.expectIndexed, out)
+
+			out, supported = f.indexedBlocks(bitmaps.without(IdxKeyCallDepthsAndTypes))
+			require.True(t, supported, "index without call depths and types falls back to addresses and signatures")
+			assert.Subset(t, out, test.expectIndexed)
+		})
+	}
+}
+
+func TestCallToFilter_Callers(t *testing.T) {
+	vault := eth.MustNewAddress("0x1111111111111111111111111111111111111111")
+	pool := eth.MustNewAddress("0x2222222222222222222222222222222222222222")
+
+	call := func(caller, address eth.Address) *pbeth.TransactionTrace {
+		return &pbeth.TransactionTrace{
+			Receipt: &pbeth.TransactionReceipt{},
+			Calls: []*pbeth.Call{
+				{CallType: pbeth.CallType_CALL, Caller: senderA, Address: caller, Depth: 0},
+				{CallType: pbeth.CallType_CALL, Caller: caller, Address: address, Depth: 1, Input: []byte{0xa9, 0x05, 0x9c, 0xbb}},
+			},
+		}
+	}
+
+	blocks := []*pbeth.Block{
+		{Number: 10, TransactionTraces: []*pbeth.TransactionTrace{call(vault, pool)}},
+		{Number: 11, TransactionTraces: []*pbeth.TransactionTrace{call(vault, tokenAddr)}},
+		{Number: 12, TransactionTraces: []*pbeth.TransactionTrace{call(recipient, pool)}},
+	}
+	bitmaps := indexBlocks(blocks...)
+
+	tests := []struct {
+		name   string
+		filter *pbtransform.CallToFilter
+		expect []uint64
+	}{
+		{"caller", &pbtransform.CallToFilter{Callers: [][]byte{vault}}, []uint64{10, 11}},
+		{"caller and address", &pbtransform.CallToFilter{Callers: [][]byte{vault}, Addresses: [][]byte{pool}}, []uint64{10}},
+		{"caller and signature", &pbtransform.CallToFilter{Callers: [][]byte{recipient}, Signatures: [][]byte{{0xa9, 0x05, 0x9c, 0xbb}}}, []uint64{12}},
+		{"no match", &pbtransform.CallToFilter{Callers: [][]byte{pool}}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := newCombinedFilter(&pbtransform.CombinedFilter{CallFilters: []*pbtransform.CallToFilter{test.filter}}, nil, nil)
+			require.NoError(t, err)
+
+			var matching []uint64
+			for _, blk := range blocks {
+				if f.matches(blk.TransactionTraces[0]) {
+					matching = append(matching, blk.Number)
+				}
+			}
+			assert.Equal(t, test.expect, matching)
+
+			out, supported := f.indexedBlocks(bitmaps)
+			require.True(t, supported)
+			assert.Equal(t, test.expect, out)
+
+			out, supported = f.indexedBlocks(bitmaps.without(IdxKeyCallCallers))
+			require.True(t, supported, "index without callers falls back to addresses and signatures or blocks with calls")
+			assert.Subset(t, out, test.expect)
+		})
+	}
+}
+
+func TestCallKeys_CallersUsePrefix(t *testing.T) {
+	trace := &pbeth.TransactionTrace{Calls: []*pbeth.Call{{Caller: senderA, Address: tokenAddr}}}
+
+	assert.Equal(t, IdxPrefixCallCaller, callCallerPrefix(IdxPrefixCall))
+	assert.Equal(t, map[string]bool{
+		"Z" + "cccccccccccccccccccccccccccccccccccccccc":  true,
+		"ZF" + "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa": true,
+	}, callKeys(trace, "Z"))
+}
+
+func TestCallToFilter_Invalid(t *testing.T) {
+	maxDepth := uint32(1)
+	_, err := NewCallToFilter(&pbtransform.CallToFilter{Addresses: [][]byte{tokenAddr}, MinDepth: 2, MaxDepth: &maxDepth})
+	require.Error(t, err)
+
+	_, err = NewCallToFilter(&pbtransform.CallToFilter{CallTypes: []pbeth.CallType{pbeth.CallType_DELEGATE}})
+	require.Error(t, err)
+}
+
+func TestCallToFilter_String(t *testing.T) {
+	maxDepth := uint32(0)
+	f, err := NewCallToFilter(&pbtransform.CallToFilter{Addresses: [][]byte{eth.MustNewHex("0xdeadbeef")}, MaxDepth: &maxDepth, CallTypes: []pbeth.CallType{pbeth.CallType_CALL}, Callers: [][]byte{eth.MustNewHex("0xbeef")}})
+	require.NoError(t, err)
+	assert.Equal(t, "{addrs: 0xdeadbeef, sigs: , depth: 0..0, callers: 0xbeef, types: CALL}", addSigString(f, 5))
+}
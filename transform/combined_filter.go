@@ -2,9 +2,12 @@ package transform
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/streamingfast/bstream"
 
@@ -17,6 +20,7 @@ import (
 	firecore "github.com/streamingfast/firehose-core"
 	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
 	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
@@ -25,6 +29,49 @@ const IdxPrefixEmpty = "" //
 const IdxPrefixLog = "L"  // log prefix for combined index
 const IdxPrefixCall = "C" // call prefix for combined index
 
+const IdxPrefixCallTopLevel = "CT" // top-level call (depth 0) address and method prefix for combined index
+const IdxPrefixCallType = "CY"     // call type and `:` followed by call address and method prefix for combined index
+const IdxPrefixCallCaller = "CF"   // caller of calls prefix for combined index, callCallerPrefix(IdxPrefixCall)
+
+const IdxPrefixTrxFrom = "TF"       // transaction sender prefix for combined index
+const IdxPrefixTrxTo = "TT"         // transaction recipient prefix for combined index
+const IdxPrefixTrxType = "TY"       // transaction type prefix for combined index
+const IdxPrefixTrxStatus = "TS"     // transaction status prefix for combined index
+const IdxPrefixStorage = "S"        // storage change address and key prefix for combined index
+const IdxPrefixBalance = "B"        // balance change address prefix for combined index
+const IdxPrefixBalanceReason = "BR" // balance change reason prefix for combined index
+
+const IdxPrefixCreationDeployer = "KD" // contract creation transaction sender prefix for combined index
+const IdxPrefixCreationFactory = "KF"  // contract creation caller prefix for combined index
+const IdxPrefixCreationCodeHash = "KH" // contract creation code hash prefix for combined index
+const IdxKeyHasContractCreation = "KC" // key of blocks containing at least one contract creation in combined index
+
+const IdxPrefixValueFrom = "VF"     // value transfer sender prefix for combined index
+const IdxPrefixValueTo = "VT"       // value transfer recipient prefix for combined index
+const IdxKeyHasValueTransfer = "VH" // key of blocks containing at least one value transfer in combined index
+
+const IdxPrefixBlobSender = "XF"      // blob transaction sender prefix for combined index
+const IdxPrefixBlobHash = "XH"        // blob versioned hash prefix for combined index
+const IdxKeyHasBlobTransaction = "XC" // key of blocks containing at least one blob transaction in combined index
+
+const IdxPrefixSetCodeAuthority = "DA" // set code authorization authority prefix for combined index
+const IdxPrefixSetCodeAddress = "DT"   // set code authorization delegated address prefix for combined index
+const IdxKeyHasSetCode = "DC"          // key of blocks containing at least one set code authorization in combined index
+
+// The marker keys are added for every block of the index files containing a feature added over time to the
+// combined index (see IndexFeatures), index files produced before a feature was indexed don't have its marker
+// and cannot be used with the filters requiring it.
+const IdxKeyLogTopics = IdxPrefixLog + "T"           // log topics 1 to 3, under logTopicPrefix prefixes
+const IdxKeyCallDepthsAndTypes = IdxPrefixCall + "D" // top-level calls and calls by type, under IdxPrefixCallTopLevel and IdxPrefixCallType
+const IdxKeyCallCallers = IdxPrefixCallCaller        // callers of calls, under IdxPrefixCallCaller
+const IdxKeyTransactions = "T"                       // transactions, under IdxPrefixTrx* prefixes
+const IdxKeyStorageChanges = IdxPrefixStorage        // storage changes, under IdxPrefixStorage
+const IdxKeyBalanceChanges = IdxPrefixBalance        // balance changes, under IdxPrefixBalance* prefixes
+const IdxKeyContractCreations = "K"                  // contract creations, under IdxPrefixCreation* prefixes
+const IdxKeyValueTransfers = "V"                     // value transfers, under IdxPrefixValue* prefixes
+const IdxKeyBlobs = "X"                              // blob transactions, under IdxPrefixBlob* prefixes
+const IdxKeySetCodes = "D"                           // set code authorizations, under IdxPrefixSetCode* prefixes
+
 const CombinedIndexerShortName = "combined"
 
 type Indexer interface {
@@ -38,6 +85,13 @@ func NewCombinedFilterTransformFactory(indexStore dstore.Store, possibleIndexSiz
 }
 
 func CombinedFilterTransformFactory(indexStore dstore.Store, possibleIndexSizes []uint64) *transform.Factory {
+	return CombinedFilterTransformFactoryWithLogsBloom(indexStore, possibleIndexSizes, false)
+}
+
+// CombinedFilterTransformFactoryWithLogsBloom is CombinedFilterTransformFactory with the block header logs bloom
+// check of CombinedFilter.Transform turned on or off, it must stay off on chains where the header logs bloom doesn't
+// cover all the logs of the block, like Polygon whose state sync logs are not part of it.
+func CombinedFilterTransformFactoryWithLogsBloom(indexStore dstore.Store, possibleIndexSizes []uint64, useLogsBloom bool) *transform.Factory {
 	return &transform.Factory{
 		Obj: &pbtransform.CombinedFilter{},
 		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
@@ -52,61 +106,189 @@ func CombinedFilterTransformFactory(indexStore dstore.Store, possibleIndexSizes
 				return nil, fmt.Errorf("unexpected unmarshall error: %w", err)
 			}
 
-			if len(filter.CallFilters) == 0 && len(filter.LogFilters) == 0 && !filter.SendAllBlockHeaders {
-				return nil, fmt.Errorf("a combined filter transform requires at-least one callto filter, one log filter or it must have have send_all_block_headers enabled")
+			f, err := newCombinedFilter(filter, indexStore, possibleIndexSizes)
+			if err != nil {
+				return nil, err
+			}
+			if err := f.validate(); err != nil {
+				return nil, err
 			}
+			f.useLogsBloom = useLogsBloom
+			return f, nil
+		},
+	}
+}
 
-			return newCombinedFilter(filter.CallFilters, filter.LogFilters, indexStore, possibleIndexSizes, filter.SendAllBlockHeaders)
+func newCombinedFilter(in *pbtransform.CombinedFilter, indexStore dstore.Store, possibleIndexSizes []uint64) (*CombinedFilter, error) {
+	f := &CombinedFilter{
+		indexStore:          indexStore,
+		possibleIndexSizes:  possibleIndexSizes,
+		sendAllBlockHeaders: in.SendAllBlockHeaders,
 
-		},
+		excludeFailedTransactions: in.ExcludeFailedTransactions,
+		excludeRevertedCalls:      in.ExcludeRevertedCalls,
+	}
+
+	err := errors.Join(
+		addFilters(f, "Calls", &f.CallToFilters, in.CallFilters, NewCallToFilter),
+		addFilters(f, "Logs", &f.LogFilters, in.LogFilters, NewLogFilter),
+		addFilters(f, "Transactions", &f.TransactionFilters, in.TransactionFilters, NewTransactionFilter),
+		addFilters(f, "StorageChanges", &f.StorageChangeFilters, in.StorageChangeFilters, NewStorageChangeFilter),
+		addFilters(f, "BalanceChanges", &f.BalanceChangeFilters, in.BalanceChangeFilters, NewBalanceChangeFilter),
+		addFilters(f, "ContractCreations", &f.ContractCreationFilters, in.ContractCreationFilters, NewContractCreationFilter),
+		addFilters(f, "ValueTransfers", &f.ValueTransferFilters, in.ValueTransferFilters, NewValueTransferFilter),
+		addFilters(f, "Blobs", &f.BlobFilters, in.BlobFilters, NewBlobFilter),
+		addFilters(f, "SetCodes", &f.SetCodeFilters, in.SetCodeFilters, NewSetCodeFilter),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Expression != nil {
+		if f.Expression, err = NewFilterExpression(in.Expression); err != nil {
+			return nil, err
+		}
+	}
+
+	if f.excludeRevertedCalls {
+		f.ignoreReverted()
 	}
+
+	return f, nil
 }
 
-func newCombinedFilter(pbCallToFilters []*pbtransform.CallToFilter, pbLogFilters []*pbtransform.LogFilter, indexStore dstore.Store, possibleIndexSizes []uint64, sendAllBlockHeaders bool) (*CombinedFilter, error) {
-	var callToFilters []*CallToFilter
-	if l := len(pbCallToFilters); l > 0 {
-		callToFilters = make([]*CallToFilter, l)
-		for i, in := range pbCallToFilters {
-			f, err := NewCallToFilter(in)
-			if err != nil {
-				return nil, err
-			}
-			callToFilters[i] = f
+// addFilters creates the filters of one kind of the request, setting them on their CombinedFilter field (out)
+// and adding them to the filter kinds that the CombinedFilter matches and looks up in the index
+func addFilters[In any, F traceFilter](f *CombinedFilter, name string, out *[]F, in []In, newFilter func(In) (F, error)) error {
+	kind := filterKind{name: name}
+	for _, in := range in {
+		filter, err := newFilter(in)
+		if err != nil {
+			return err
 		}
+		*out = append(*out, filter)
+		kind.filters = append(kind.filters, filter)
 	}
 
-	var logFilters []*LogFilter
+	f.kinds = append(f.kinds, kind)
+	return nil
+}
 
-	if l := len(pbLogFilters); l > 0 {
-		logFilters = make([]*LogFilter, l)
-		for i, in := range pbLogFilters {
-			f, err := NewLogFilter(in)
-			if err != nil {
-				return nil, err
-			}
-			logFilters[i] = f
+func (f *CombinedFilter) validate() error {
+	if !f.hasFilters() && !f.sendAllBlockHeaders {
+		return fmt.Errorf("a combined filter transform requires at-least one callto filter, one log filter, one transaction filter, one storage change filter, one balance change filter, one contract creation filter, one value transfer filter, one blob filter, one set code filter, an expression or it must have have send_all_block_headers enabled")
+	}
+	return nil
+}
+
+// hasFilters returns true when the filter has at least one filter of any kind or an expression
+func (f *CombinedFilter) hasFilters() bool {
+	for _, kind := range f.kinds {
+		if len(kind.filters) != 0 {
+			return true
 		}
 	}
+	return f.Expression != nil
+}
 
-	f := &CombinedFilter{
-		CallToFilters:       callToFilters,
-		LogFilters:          logFilters,
-		indexStore:          indexStore,
-		possibleIndexSizes:  possibleIndexSizes,
-		sendAllBlockHeaders: sendAllBlockHeaders,
+// traceFilter is implemented by all the kinds of filters, which a CombinedFilter matches and looks up in
+// the index the same way, and which can be used as the leaves of a FilterExpression
+type traceFilter interface {
+	matches(trace *pbeth.TransactionTrace) bool
+
+	// bitmap finds the blockNums which may contain a transaction matching the filter
+	bitmap(bitmaps transform.BitmapGetter) *roaring64.Bitmap
+
+	// indexMarker is the marker key of the index feature required to evaluate bitmap, empty when all index
+	// files can be used
+	indexMarker() string
+}
+
+// filterKind holds the filters of one kind of a CombinedFilter
+type filterKind struct {
+	name    string
+	filters []traceFilter
+}
+
+// indexBitmap returns the bitmap of the filter, supported is false when the index doesn't contain the keys
+// required to evaluate it
+func indexBitmap(filter traceFilter, bitmaps transform.BitmapGetter) (out *roaring64.Bitmap, supported bool) {
+	if marker := filter.indexMarker(); marker != "" && bitmaps.Get(marker) == nil {
+		return nil, false
 	}
+	return filter.bitmap(bitmaps), true
+}
 
-	return f, nil
+// filterString returns the description of the filter listed by the String of CombinedFilter and FilterExpression,
+// at most limit addresses and signatures are listed
+func filterString(filter traceFilter, limit int) string {
+	switch f := filter.(type) {
+	case AddressSignatureFilter:
+		return addSigString(f, limit)
+	case fmt.Stringer:
+		return f.String()
+	}
+	return fmt.Sprintf("%T", filter)
 }
 
 type CombinedFilter struct {
-	CallToFilters []*CallToFilter
-	LogFilters    []*LogFilter
+	CallToFilters           []*CallToFilter
+	LogFilters              []*LogFilter
+	TransactionFilters      []*TransactionFilter
+	StorageChangeFilters    []*StorageChangeFilter
+	BalanceChangeFilters    []*BalanceChangeFilter
+	ContractCreationFilters []*ContractCreationFilter
+	ValueTransferFilters    []*ValueTransferFilter
+	BlobFilters             []*BlobFilter
+	SetCodeFilters          []*SetCodeFilter
+	Expression              *FilterExpression
+
+	// kinds holds the filters above by kind, in the order they are listed by String
+	kinds []filterKind
 
 	indexStore         dstore.Store
 	possibleIndexSizes []uint64
 
 	sendAllBlockHeaders bool
+
+	excludeFailedTransactions bool
+	excludeRevertedCalls      bool
+
+	// useLogsBloom skips the decoding of the transaction traces of the blocks not covered by the index
+	// whose header logs bloom proves that none of them matches
+	useLogsBloom bool
+
+	// indexProvider is the provider returned by GetIndexProvider, it tells which blocks were selected
+	// using the index
+	indexProvider *combinedIndexProvider
+}
+
+// ignoreReverted makes the call and log filters, including the ones of the expression, skip the
+// reverted calls and the logs of failed transactions
+func (f *CombinedFilter) ignoreReverted() {
+	ignore := func(leaf traceFilter) {
+		switch leaf := leaf.(type) {
+		case *CallToFilter:
+			leaf.ignoreReverted = true
+		case *LogFilter:
+			leaf.ignoreReverted = true
+		}
+	}
+
+	for _, kind := range f.kinds {
+		for _, filter := range kind.filters {
+			ignore(filter)
+		}
+	}
+	if f.Expression != nil {
+		f.Expression.visitLeaves(ignore)
+	}
+}
+
+// traceFailed returns true when the transaction failed or was reverted, in which case none
+// of its calls has an effect on the chain
+func traceFailed(trace *pbeth.TransactionTrace) bool {
+	return trace.Status == pbeth.TransactionTraceStatus_FAILED || trace.Status == pbeth.TransactionTraceStatus_REVERTED
 }
 
 type EthCombinedIndexer struct {
@@ -124,15 +306,34 @@ func NewEthCombinedIndexerLegacy(indexStore dstore.Store, indexSize uint64) *Eth
 	}
 }
 
+// transactionIndexKeys are the functions returning the index keys of each kind of filter for a transaction
+var transactionIndexKeys = []func(trace *pbeth.TransactionTrace) map[string]bool{
+	func(trace *pbeth.TransactionTrace) map[string]bool { return callKeys(trace, IdxPrefixCall) },
+	callDetailKeys,
+	func(trace *pbeth.TransactionTrace) map[string]bool { return logKeys(trace, IdxPrefixLog) },
+	transactionKeys,
+	func(trace *pbeth.TransactionTrace) map[string]bool { return storageChangeKeys(trace, IdxPrefixStorage) },
+	callsBalanceChangeKeys,
+	contractCreationKeys,
+	valueTransferKeys,
+	blobKeys,
+	setCodeKeys,
+}
+
 // ProcessBlock implements chain-specific logic for Ethereum pbbstream.Block's
 func (i *EthCombinedIndexer) ProcessBlock(blk *pbeth.Block) error {
 	keys := make(map[string]bool)
+	for _, feature := range IndexFeatures {
+		keys[feature.Marker] = true
+	}
+	for key := range balanceChangeKeys(blk.BalanceChanges) {
+		keys[key] = true
+	}
 	for _, trace := range blk.TransactionTraces {
-		for key := range callKeys(trace, IdxPrefixCall) {
-			keys[key] = true
-		}
-		for key := range logKeys(trace, IdxPrefixLog) {
-			keys[key] = true
+		for _, traceKeys := range transactionIndexKeys {
+			for key := range traceKeys(trace) {
+				keys[key] = true
+			}
 		}
 	}
 	keyArray := make([]string, 0, len(keys))
@@ -159,8 +360,51 @@ func addSigString(in AddressSignatureFilter, limit int) string {
 		}
 		signatures = append(signatures, s.Pretty())
 	}
-	return fmt.Sprintf("{addrs: %s, sigs: %s}", strings.Join(addresses, ","), strings.Join(signatures, ","))
 
+	// constraints other than addresses and signatures, only listed when present
+	var constraints string
+	if lf, ok := in.(*LogFilter); ok {
+		for position := 1; position <= len(lf.topics); position++ {
+			var values []string
+			for i, t := range lf.Topics(position) {
+				if i > limit {
+					break
+				}
+				values = append(values, t.Pretty())
+			}
+			if len(values) > 0 {
+				constraints += fmt.Sprintf(", topic%d: %s", position, strings.Join(values, ","))
+			}
+		}
+	}
+
+	if cf, ok := in.(*CallToFilter); ok {
+		if cf.minDepth != 0 || cf.maxDepth != nil {
+			constraints += fmt.Sprintf(", depth: %d..", cf.minDepth)
+			if cf.maxDepth != nil {
+				constraints += strconv.FormatUint(uint64(*cf.maxDepth), 10)
+			}
+		}
+		var callers []string
+		for i, caller := range cf.callers {
+			if i > limit {
+				break
+			}
+			callers = append(callers, caller.Pretty())
+		}
+		if len(callers) > 0 {
+			constraints += ", callers: " + strings.Join(callers, ",")
+		}
+		if len(cf.callTypes) != 0 {
+			types := make([]string, len(cf.callTypes))
+			for i, callType := range cf.callTypes {
+				types[i] = callType.String()
+			}
+			constraints += ", types: " + strings.Join(types, ",")
+		}
+	}
+
+	return fmt.Sprintf("{addrs: %s, sigs: %s%s}", strings.Join(addresses, ","), strings.Join(signatures, ","), constraints)
 }
 
 func truncate(in string, size int, suffix string) string {
@@ -180,45 +424,103 @@ func (f *CombinedFilter) String() string {
 		limit = 999999
 	}
 
-	callFilters := make([]string, len(f.CallToFilters))
-	for i, f := range f.CallToFilters {
-		callFilters[i] = addSigString(f, limit)
+	var callFilters, logFilters []string
+	// Filters kinds other than calls and logs are only listed when present
+	var others string
+	for _, kind := range f.kinds {
+		filters := make([]string, len(kind.filters))
+		for i, filter := range kind.filters {
+			filters[i] = filterString(filter, limit)
+		}
+
+		switch kind.name {
+		case "Calls":
+			callFilters = filters
+		case "Logs":
+			logFilters = filters
+		default:
+			others += optionalFiltersString(kind.name, filters, debug)
+		}
+	}
+
+	if f.Expression != nil {
+		others += optionalFiltersString("Expression", []string{f.Expression.String()}, debug)
+	}
+
+	if f.excludeFailedTransactions {
+		others += ", ExcludeFailedTransactions: true"
 	}
-	logFilters := make([]string, len(f.LogFilters))
-	for i, f := range f.LogFilters {
-		logFilters[i] = addSigString(f, limit)
+	if f.excludeRevertedCalls {
+		others += ", ExcludeRevertedCalls: true"
 	}
 
 	if debug {
-		return fmt.Sprintf("Combined filter: Calls:[%s], Logs:[%s], SendAllBlockHeaders: %v", strings.Join(callFilters, ","), strings.Join(logFilters, ","), f.sendAllBlockHeaders)
+		return fmt.Sprintf("Combined filter: Calls:[%s], Logs:[%s]%s, SendAllBlockHeaders: %v", strings.Join(callFilters, ","), strings.Join(logFilters, ","), others, f.sendAllBlockHeaders)
+	}
+
+	return fmt.Sprintf("Combined filter: Calls:[%s], Logs:[%s]%s, SendAllBlockHeaders: %v", truncate(strings.Join(callFilters, ","), 90, "...}"), truncate(strings.Join(logFilters, ","), 90, "...}"), others, f.sendAllBlockHeaders)
+}
+
+func optionalFiltersString(name string, filters []string, debug bool) string {
+	if len(filters) == 0 {
+		return ""
 	}
 
-	return fmt.Sprintf("Combined filter: Calls:[%s], Logs:[%s], SendAllBlockHeaders: %v", truncate(strings.Join(callFilters, ","), 90, "...}"), truncate(strings.Join(logFilters, ","), 90, "...}"), f.sendAllBlockHeaders)
+	joined := strings.Join(filters, ",")
+	if !debug {
+		joined = truncate(joined, 90, "...}")
+	}
+	return fmt.Sprintf(", %s:[%s]", name, joined)
 }
 
 func (f *CombinedFilter) matches(trace *pbeth.TransactionTrace) bool {
-	for _, lf := range f.LogFilters {
-		if lf.matches(trace) {
-			return true
-		}
+	if f.excludeFailedTransactions && traceFailed(trace) {
+		return false
 	}
-	for _, cf := range f.CallToFilters {
-		if cf.matches(trace) {
-			return true
+
+	for _, kind := range f.kinds {
+		for _, filter := range kind.filters {
+			if filter.matches(trace) {
+				return true
+			}
 		}
 	}
+	if f.Expression != nil && f.Expression.matches(trace) {
+		return true
+	}
 	return false
 }
 
+// Transform keeps the transactions matching the filter. The transaction traces, the bulk of a block, are decoded
+// apart from the other fields so that when the block header logs bloom proves that no transaction can match (see
+// mayMatchLogsBloom), they are not decoded at all, which keeps the ranges not covered by an index cheap to filter.
+// The blocks selected using the index are always decoded, the index is more precise than the logs bloom and covers
+// the logs that the bloom may miss.
 func (f *CombinedFilter) Transform(readOnlyBlk *pbbstream.Block, in transform.Input) (transform.Output, error) {
 	ethBlock := &pbeth.Block{}
-	err := readOnlyBlk.Payload.UnmarshalTo(ethBlock)
+	if !readOnlyBlk.Payload.MessageIs(ethBlock) {
+		return nil, fmt.Errorf("mashalling block: unexpected payload type %q", readOnlyBlk.Payload.TypeUrl)
+	}
+
+	others, encodedTraces, err := splitTransactionTraces(readOnlyBlk.Payload.Value)
 	if err != nil {
 		return nil, fmt.Errorf("mashalling block: %w", err)
 	}
+	if err := proto.Unmarshal(others, ethBlock); err != nil {
+		return nil, fmt.Errorf("mashalling block: %w", err)
+	}
 
 	traces := []*pbeth.TransactionTrace{}
-	for _, trace := range ethBlock.TransactionTraces {
+	if f.useLogsBloom && !f.indexProvider.indexed(readOnlyBlk.Number) && !f.mayMatchLogsBloom(ethBlock.Header.GetLogsBloom()) {
+		ethBlock.TransactionTraces = traces
+		return ethBlock, nil
+	}
+
+	for _, encoded := range encodedTraces {
+		trace := &pbeth.TransactionTrace{}
+		if err := proto.Unmarshal(encoded, trace); err != nil {
+			return nil, fmt.Errorf("mashalling transaction trace: %w", err)
+		}
 		if f.matches(trace) {
 			traces = append(traces, trace)
 		}
@@ -227,6 +529,53 @@ func (f *CombinedFilter) Transform(readOnlyBlk *pbbstream.Block, in transform.In
 	return ethBlock, nil
 }
 
+var blockTransactionTracesField = (&pbeth.Block{}).ProtoReflect().Descriptor().Fields().ByName("transaction_traces").Number()
+
+// splitTransactionTraces separates the encoded transaction traces of an encoded pbeth.Block from its other fields,
+// the traces are sub-slices of data
+func splitTransactionTraces(data []byte) (others []byte, traces [][]byte, err error) {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, nil, protowire.ParseError(n)
+		}
+		m := protowire.ConsumeFieldValue(num, typ, data[n:])
+		if m < 0 {
+			return nil, nil, protowire.ParseError(m)
+		}
+
+		if num == blockTransactionTracesField && typ == protowire.BytesType {
+			trace, _ := protowire.ConsumeBytes(data[n:])
+			traces = append(traces, trace)
+		} else {
+			others = append(others, data[:n+m]...)
+		}
+		data = data[n+m:]
+	}
+	return others, traces, nil
+}
+
+// mayMatchLogsBloom returns false when no transaction of the block having this header logs bloom can match the
+// filter, which can only be told when all the filters require a log, a missing bloom may match anything
+func (f *CombinedFilter) mayMatchLogsBloom(bloom []byte) bool {
+	if len(bloom) != logsBloomSize {
+		return true
+	}
+
+	for _, kind := range f.kinds {
+		for _, filter := range kind.filters {
+			lf, ok := filter.(*LogFilter)
+			if !ok || lf.mayMatchLogsBloom(bloom) {
+				return true
+			}
+		}
+	}
+	if f.Expression != nil && f.Expression.mayMatchLogsBloom(bloom) {
+		return true
+	}
+	return false
+}
+
 // GetIndexProvider will instantiate a new index conforming to the pbbstream.BlockIndexProvider interface
 func (f *CombinedFilter) GetIndexProvider() bstream.BlockIndexProvider {
 	if f.indexStore == nil {
@@ -237,32 +586,110 @@ func (f *CombinedFilter) GetIndexProvider() bstream.BlockIndexProvider {
 		return nil
 	}
 
-	if len(f.CallToFilters) == 0 && len(f.LogFilters) == 0 {
+	if !f.hasFilters() {
 		return nil
 	}
 
-	return transform.NewGenericBlockIndexProvider(
+	provider := &combinedIndexProvider{}
+	provider.GenericBlockIndexProvider = transform.NewGenericBlockIndexProvider(
 		f.indexStore,
 		CombinedIndexerShortName,
 		f.possibleIndexSizes,
-		getcombinedFilterFunc(f.CallToFilters, f.LogFilters),
+		func(bitmaps transform.BitmapGetter) []uint64 {
+			matchingBlocks, supported := f.indexedBlocks(bitmaps)
+			provider.unsupportedIndex = !supported
+			return matchingBlocks
+		},
 	)
 
+	f.indexProvider = provider
+	return provider
+}
+
+// combinedIndexProvider is a transform.GenericBlockIndexProvider that refuses to answer from index files
+// which were produced before some keys required by the filter were indexed, which deactivates the
+// block skipping instead of skipping blocks that might match.
+//
+// It also records the range of blocks it answered for, the stream only reads the blocks of this range that the
+// index returned, while all the blocks after it (once the index is deactivated) are read.
+type combinedIndexProvider struct {
+	*transform.GenericBlockIndexProvider
+
+	unsupportedIndex bool
+
+	lock         sync.Mutex
+	indexedFrom  uint64
+	indexedUntil uint64
+}
+
+func (p *combinedIndexProvider) BlocksInRange(baseBlockNum, bundleSize uint64) ([]uint64, error) {
+	out, err := p.GenericBlockIndexProvider.BlocksInRange(baseBlockNum, bundleSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.unsupportedIndex {
+		return nil, fmt.Errorf("index containing block_num %d does not contain the keys required by the filter", baseBlockNum)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if baseBlockNum < p.indexedFrom || baseBlockNum > p.indexedUntil {
+		p.indexedFrom = baseBlockNum
+	}
+	p.indexedUntil = max(p.indexedUntil, baseBlockNum+bundleSize)
+
+	return out, nil
 }
 
-func getcombinedFilterFunc(callFilters []*CallToFilter, logFilters []*LogFilter) func(transform.BitmapGetter) []uint64 {
-	return func(bitmaps transform.BitmapGetter) (matchingBlocks []uint64) {
-		out := roaring64.NewBitmap()
-		for _, f := range logFilters {
-			fbit := filterBitmap(f, bitmaps, IdxPrefixLog)
-			out.Or(fbit)
+// indexed returns true when the block is in the range answered by the index, a nil provider answers no range
+func (p *combinedIndexProvider) indexed(blockNum uint64) bool {
+	if p == nil {
+		return false
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return blockNum >= p.indexedFrom && blockNum < p.indexedUntil
+}
+
+// indexedBlocks returns the blocks of the index matching the filter, supported is false when the index
+// doesn't contain the keys required to evaluate the filter.
+func (f *CombinedFilter) indexedBlocks(bitmaps transform.BitmapGetter) (matchingBlocks []uint64, supported bool) {
+	// balance change filters also match the block level balance changes (rewards, withdrawals), which are
+	// not part of any transaction, so their blocks are kept regardless of the transactions status
+	out := roaring64.NewBitmap()
+	blockLevel := roaring64.NewBitmap()
+	for _, kind := range f.kinds {
+		for _, filter := range kind.filters {
+			bm, supported := indexBitmap(filter, bitmaps)
+			if !supported {
+				return nil, false
+			}
+			if isBalanceChangeFilter(filter) {
+				blockLevel.Or(bm)
+			} else {
+				out.Or(bm)
+			}
+		}
+	}
+	if f.Expression != nil {
+		bm, supported := f.Expression.bitmap(bitmaps)
+		if !supported {
+			return nil, false
 		}
-		for _, f := range callFilters {
-			fbit := filterBitmap(f, bitmaps, IdxPrefixCall)
-			out.Or(fbit)
+		if f.Expression.hasLeaf(isBalanceChangeFilter) {
+			blockLevel.Or(bm)
+		} else {
+			out.Or(bm)
 		}
-		return nilIfEmpty(out.ToArray())
 	}
+
+	if f.excludeFailedTransactions && bitmaps.Get(IdxKeyTransactions) != nil {
+		out.And(enumBitmap([]pbeth.TransactionTraceStatus{pbeth.TransactionTraceStatus_UNKNOWN, pbeth.TransactionTraceStatus_SUCCEEDED}, bitmaps, IdxPrefixTrxStatus))
+	}
+	out.Or(blockLevel)
+	return nilIfEmpty(out.ToArray()), true
 }
 
 func logKeys(trace *pbeth.TransactionTrace, prefix string) map[string]bool {
@@ -275,12 +702,30 @@ func logKeys(trace *pbeth.TransactionTrace, prefix string) map[string]bool {
 			continue
 		}
 		out[prefix+hex.EncodeToString(log.Address)] = true
-		if len(log.Topics) != 0 {
-			out[prefix+hex.EncodeToString(log.Topics[0])] = true
+		for position, topic := range log.Topics {
+			if position == 0 {
+				out[prefix+hex.EncodeToString(topic)] = true
+				continue
+			}
+			if position > 3 {
+				break
+			}
+			out[logTopicPrefix(prefix, position)+hex.EncodeToString(topic)] = true
 		}
 	}
 	return out
 }
+
+// logTopicPrefix returns the index prefix used for the log topic at the given position (1 to 3)
+func logTopicPrefix(prefix string, position int) string {
+	return prefix + "T" + strconv.Itoa(position)
+}
+
+// callCallerPrefix returns the prefix of the callers keys written by callKeys with the given calls prefix
+func callCallerPrefix(prefix string) string {
+	return prefix + "F"
+}
+
 func callKeys(trace *pbeth.TransactionTrace, prefix string) map[string]bool {
 	out := make(map[string]bool)
 	for _, call := range trace.Calls {
@@ -288,6 +733,9 @@ func callKeys(trace *pbeth.TransactionTrace, prefix string) map[string]bool {
 		if sig := call.Method(); sig != nil {
 			out[prefix+hex.EncodeToString(sig)] = true
 		}
+		if len(call.Caller) != 0 {
+			out[callCallerPrefix(prefix)+hex.EncodeToString(call.Caller)] = true
+		}
 	}
 	return out
 }
@@ -318,6 +766,46 @@ func filterBitmap(f AddressSignatureFilter, bitmaps transform.BitmapGetter, idxP
 	}
 }
 
+// logFilterBitmap finds the blockNums matching the provided LogFilter, narrowing down the
+// addresses/signatures results with the topic constraints when the index contains topics
+func logFilterBitmap(f *LogFilter, bitmaps transform.BitmapGetter, idxPrefix string) *roaring64.Bitmap {
+	var out *roaring64.Bitmap
+	if len(f.Addresses()) != 0 || len(f.Signatures()) != 0 {
+		out = filterBitmap(f, bitmaps, idxPrefix)
+	}
+
+	if !f.hasTopics() {
+		return out
+	}
+
+	if bitmaps.Get(IdxKeyLogTopics) == nil {
+		// index produced before topics were indexed, we can only rely on the addresses/signatures, or
+		// on the fact that the block contains at least one log when the filter has only topics
+		if out == nil {
+			out = roaring64.NewBitmap()
+			if bm := bitmaps.GetByPrefixAndSuffix(idxPrefix, ""); bm != nil {
+				out.Or(bm)
+			}
+		}
+		return out
+	}
+
+	for position := 1; position <= len(f.topics); position++ {
+		topics := f.Topics(position)
+		if len(topics) == 0 {
+			continue
+		}
+
+		bm := sigsBitmap(topics, bitmaps, logTopicPrefix(idxPrefix, position))
+		if out == nil {
+			out = bm
+			continue
+		}
+		out.And(bm)
+	}
+	return out
+}
+
 // addressBitmap attempts to find the blockNums corresponding to the provided eth.Address
 func addressBitmap(addrs []eth.Address, bitmaps transform.BitmapGetter, idxPrefix string) *roaring64.Bitmap {
 	out := roaring64.NewBitmap()
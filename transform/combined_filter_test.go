@@ -1,17 +1,24 @@
 package transform
 
 import (
+	"encoding/binary"
 	"strings"
 	"testing"
 
+	"github.com/RoaringBitmap/roaring/roaring64"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/dstore"
 	"github.com/streamingfast/eth-go"
 	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/test-go/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 func TestString(t *testing.T) {
-	c, err := newCombinedFilter(nil, nil, nil, nil, false)
+	c, err := newCombinedFilter(&pbtransform.CombinedFilter{}, nil, nil)
 	require.NoError(t, err)
 	assert.Equal(t, "Combined filter: Calls:[], Logs:[], SendAllBlockHeaders: false", c.String())
 
@@ -36,7 +43,355 @@ func TestString(t *testing.T) {
 		EventSignatures: nil,
 	}
 
-	c, err = newCombinedFilter([]*pbtransform.CallToFilter{cf, cf2}, []*pbtransform.LogFilter{lf1, lf2, lf3}, nil, nil, true)
+	c, err = newCombinedFilter(&pbtransform.CombinedFilter{
+		CallFilters:         []*pbtransform.CallToFilter{cf, cf2},
+		LogFilters:          []*pbtransform.LogFilter{lf1, lf2, lf3},
+		SendAllBlockHeaders: true,
+	}, nil, nil)
 	require.NoError(t, err)
 	assert.Equal(t, "Combined filter: Calls:[{addrs: 0xdeadbeef, sigs: 0xbbbb},{addrs: 0x9999999999999999999999999999999999999999999999...}], Logs:[{addrs: 0xdeadbeef, sigs: 0xbbbb},{addrs: 0xcccc2222, sigs: },{addrs: 0x999999999999999999...}], SendAllBlockHeaders: true", c.String())
 }
+
+// testBitmaps is an in-memory combined index, it is both the Indexer receiving the keys and
+// the transform.BitmapGetter used to query them
+type testBitmaps map[string]*roaring64.Bitmap
+
+func indexBlocks(blocks ...*pbeth.Block) testBitmaps {
+	out := testBitmaps{}
+	indexer := &EthCombinedIndexer{BlockIndexer: out}
+	for _, blk := range blocks {
+		if err := indexer.ProcessBlock(blk); err != nil {
+			panic(err)
+		}
+	}
+	return out
+}
+
+func (b testBitmaps) Add(keys []string, blockNum uint64) {
+	for _, key := range keys {
+		if _, found := b[key]; !found {
+			b[key] = roaring64.NewBitmap()
+		}
+		b[key].Add(blockNum)
+	}
+}
+
+func (b testBitmaps) Get(key string) *roaring64.Bitmap {
+	return b[key]
+}
+
+func (b testBitmaps) GetByPrefixAndSuffix(prefix string, suffix string) *roaring64.Bitmap {
+	out := roaring64.NewBitmap()
+	for k, v := range b {
+		if strings.HasPrefix(k, prefix) && strings.HasSuffix(k, suffix) {
+			out.Or(v)
+		}
+	}
+	if out.IsEmpty() {
+		return nil
+	}
+	return out
+}
+
+// without returns a copy of the index without the keys starting with the given prefix, used to
+// simulate index files produced before those keys were indexed
+func (b testBitmaps) without(prefix string) testBitmaps {
+	out := testBitmaps{}
+	for k, v := range b {
+		if !strings.HasPrefix(k, prefix) {
+			out[k] = v.Clone()
+		}
+	}
+	return out
+}
+
+// filterKindTest is a test case of testFilterKind, expectIndexed are the blocks found in the index which can
+// be more than the blocks whose transactions match the filter (expectTrace)
+type filterKindTest[F any] struct {
+	name          string
+	filter        F
+	expectTrace   []uint64
+	expectIndexed []uint64
+}
+
+// testFilterKind checks that each filter, wrapped in a CombinedFilter by combine, matches the transactions of the
+// expected blocks and finds the expected blocks in the index, and that index files without the marker key of the
+// filter kind are not used
+func testFilterKind[F any](t *testing.T, blocks []*pbeth.Block, marker string, combine func(filter F) *pbtransform.CombinedFilter, tests []filterKindTest[F]) {
+	t.Helper()
+	bitmaps := indexBlocks(blocks...)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := newCombinedFilter(combine(test.filter), nil, nil)
+			require.NoError(t, err)
+
+			var matching []uint64
+			for _, blk := range blocks {
+				for _, trace := range blk.TransactionTraces {
+					if f.matches(trace) {
+						matching = append(matching, blk.Number)
+						break
+					}
+				}
+			}
+			assert.Equal(t, test.expectTrace, matching)
+
+			out, supported := f.indexedBlocks(bitmaps)
+			require.True(t, supported)
+			assert.Equal(t, test.expectIndexed, out)
+
+			_, supported = f.indexedBlocks(bitmaps.without(marker))
+			assert.False(t, supported, "index without the %q marker should not be supported", marker)
+		})
+	}
+}
+
+func TestCombinedFilter_ExcludeFailedAndReverted(t *testing.T) {
+	router := eth.MustNewAddress("0x1111111111111111111111111111111111111111")
+
+	routerCall := func(status pbeth.TransactionTraceStatus, reverted bool) *pbeth.TransactionTrace {
+		return &pbeth.TransactionTrace{
+			Status:  status,
+			Receipt: &pbeth.TransactionReceipt{Logs: []*pbeth.Log{{Address: tokenAddr, Topics: [][]byte{transferSig}}}},
+			Calls: []*pbeth.Call{
+				{CallType: pbeth.CallType_CALL, Address: senderA},
+				{CallType: pbeth.CallType_CALL, Address: router, StateReverted: reverted},
+			},
+		}
+	}
+
+	blocks := []*pbeth.Block{
+		{Number: 10, TransactionTraces: []*pbeth.TransactionTrace{routerCall(pbeth.TransactionTraceStatus_SUCCEEDED, false)}},
+		{Number: 11, TransactionTraces: []*pbeth.TransactionTrace{routerCall(pbeth.TransactionTraceStatus_SUCCEEDED, true)}},
+		{Number: 12, TransactionTraces: []*pbeth.TransactionTrace{routerCall(pbeth.TransactionTraceStatus_REVERTED, true)}},
+	}
+	bitmaps := indexBlocks(blocks...)
+
+	callToRouter := []*pbtransform.CallToFilter{{Addresses: [][]byte{router}}}
+	transferLogs := []*pbtransform.LogFilter{{EventSignatures: [][]byte{transferSig}}}
+	callInExpression := &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_CallFilter{CallFilter: callToRouter[0]}}
+
+	tests := []struct {
+		name          string
+		filter        *pbtransform.CombinedFilter
+		expectTrace   []uint64
+		expectIndexed []uint64
+	}{
+		{"default", &pbtransform.CombinedFilter{CallFilters: callToRouter}, []uint64{10, 11, 12}, []uint64{10, 11, 12}},
+		{"exclude failed", &pbtransform.CombinedFilter{CallFilters: callToRouter, ExcludeFailedTransactions: true}, []uint64{10, 11}, []uint64{10, 11}},
+		{"exclude reverted calls", &pbtransform.CombinedFilter{CallFilters: callToRouter, ExcludeRevertedCalls: true}, []uint64{10}, []uint64{10, 11, 12}},
+		{"exclude reverted calls in expression", &pbtransform.CombinedFilter{Expression: callInExpression, ExcludeRevertedCalls: true}, []uint64{10}, []uint64{10, 11, 12}},
+		{"exclude logs of failed transactions", &pbtransform.CombinedFilter{LogFilters: transferLogs, ExcludeRevertedCalls: true}, []uint64{10, 11}, []uint64{10, 11, 12}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := newCombinedFilter(test.filter, nil, nil)
+			require.NoError(t, err)
+
+			var matching []uint64
+			for _, blk := range blocks {
+				if f.matches(blk.TransactionTraces[0]) {
+					matching = append(matching, blk.Number)
+				}
+			}
+			assert.Equal(t, test.expectTrace, matching)
+
+			out, supported := f.indexedBlocks(bitmaps)
+			require.True(t, supported)
+			assert.Equal(t, test.expectIndexed, out)
+		})
+	}
+}
+
+func TestCombinedFilter_ExcludeFailedBlockLevelBalanceChanges(t *testing.T) {
+	refund := &pbeth.BalanceChange{Address: senderA, Reason: pbeth.BalanceChange_REASON_GAS_REFUND}
+	withdrawal := &pbeth.BalanceChange{Address: senderA, Reason: pbeth.BalanceChange_REASON_WITHDRAWAL}
+
+	blocks := []*pbeth.Block{
+		{Number: 10, BalanceChanges: []*pbeth.BalanceChange{withdrawal}},
+		{Number: 11, TransactionTraces: []*pbeth.TransactionTrace{{
+			Status:  pbeth.TransactionTraceStatus_FAILED,
+			Receipt: &pbeth.TransactionReceipt{},
+			Calls:   []*pbeth.Call{{BalanceChanges: []*pbeth.BalanceChange{refund}}},
+		}}},
+		{Number: 12, TransactionTraces: []*pbeth.TransactionTrace{{
+			Status:  pbeth.TransactionTraceStatus_FAILED,
+			To:      recipient,
+			Receipt: &pbeth.TransactionReceipt{},
+		}}},
+	}
+	bitmaps := indexBlocks(blocks...)
+
+	toSenderA := &pbtransform.BalanceChangeFilter{Addresses: [][]byte{senderA}}
+	toRecipient := &pbtransform.TransactionFilter{To: [][]byte{recipient}}
+
+	tests := []struct {
+		name          string
+		filter        *pbtransform.CombinedFilter
+		expectIndexed []uint64
+	}{
+		{"balance change filter", &pbtransform.CombinedFilter{
+			BalanceChangeFilters:      []*pbtransform.BalanceChangeFilter{toSenderA},
+			TransactionFilters:        []*pbtransform.TransactionFilter{toRecipient},
+			ExcludeFailedTransactions: true,
+		}, []uint64{10, 11}},
+		{"balance change filter in expression", &pbtransform.CombinedFilter{
+			Expression:                &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_BalanceChangeFilter{BalanceChangeFilter: toSenderA}},
+			TransactionFilters:        []*pbtransform.TransactionFilter{toRecipient},
+			ExcludeFailedTransactions: true,
+		}, []uint64{10, 11}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := newCombinedFilter(test.filter, nil, nil)
+			require.NoError(t, err)
+
+			out, supported := f.indexedBlocks(bitmaps)
+			require.True(t, supported)
+			assert.Equal(t, test.expectIndexed, out, "block 10 has no transaction but a matching withdrawal")
+		})
+	}
+}
+
+func TestCombinedFilter_LogsBloom(t *testing.T) {
+	router := eth.MustNewAddress("0x1111111111111111111111111111111111111111")
+
+	transferTrace := &pbeth.TransactionTrace{
+		Receipt: &pbeth.TransactionReceipt{Logs: []*pbeth.Log{{Address: tokenAddr, Topics: [][]byte{transferSig, walletA}}}},
+		Calls:   []*pbeth.Call{{CallType: pbeth.CallType_CALL, Address: router}},
+	}
+
+	// the second bloom doesn't contain the log of the trace, it's inconsistent with the block on purpose
+	// to tell apart the blocks whose traces were skipped
+	matchingBloom := testLogsBloom(tokenAddr, transferSig, walletA)
+	otherBloom := testLogsBloom(router)
+
+	transferLogs := []*pbtransform.LogFilter{{Addresses: [][]byte{tokenAddr}, EventSignatures: [][]byte{transferSig}}}
+	transferToWalletA := []*pbtransform.LogFilter{{Topic1: [][]byte{walletA}}}
+	callToRouter := []*pbtransform.CallToFilter{{Addresses: [][]byte{router}}}
+	logLeaf := &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_LogFilter{LogFilter: transferLogs[0]}}
+	callLeaf := &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_CallFilter{CallFilter: callToRouter[0]}}
+
+	tests := []struct {
+		name          string
+		filter        *pbtransform.CombinedFilter
+		bloom         []byte
+		useLogsBloom  bool
+		expectMatches int
+	}{
+		{"bloom matching", &pbtransform.CombinedFilter{LogFilters: transferLogs}, matchingBloom, true, 1},
+		{"bloom matching topics", &pbtransform.CombinedFilter{LogFilters: transferToWalletA}, matchingBloom, true, 1},
+		{"bloom not matching", &pbtransform.CombinedFilter{LogFilters: transferLogs}, otherBloom, true, 0},
+		{"bloom not matching topics", &pbtransform.CombinedFilter{LogFilters: transferToWalletA}, otherBloom, true, 0},
+		{"bloom not used", &pbtransform.CombinedFilter{LogFilters: transferLogs}, otherBloom, false, 1},
+		{"no bloom", &pbtransform.CombinedFilter{LogFilters: transferLogs}, nil, true, 1},
+		{"call filter", &pbtransform.CombinedFilter{LogFilters: transferLogs, CallFilters: callToRouter}, otherBloom, true, 1},
+		{"and expression", &pbtransform.CombinedFilter{Expression: &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_And{And: &pbtransform.FilterExpressions{Expressions: []*pbtransform.FilterExpression{logLeaf, callLeaf}}}}}, otherBloom, true, 0},
+		{"or expression", &pbtransform.CombinedFilter{Expression: &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_Or{Or: &pbtransform.FilterExpressions{Expressions: []*pbtransform.FilterExpression{logLeaf, callLeaf}}}}}, otherBloom, true, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := newCombinedFilter(test.filter, nil, nil)
+			require.NoError(t, err)
+			f.useLogsBloom = test.useLogsBloom
+
+			blk := &pbeth.Block{
+				Number:            10,
+				Header:            &pbeth.BlockHeader{Number: 10, LogsBloom: test.bloom},
+				TransactionTraces: []*pbeth.TransactionTrace{transferTrace},
+				BalanceChanges:    []*pbeth.BalanceChange{{Address: walletB, Reason: pbeth.BalanceChange_REASON_REWARD_MINE_BLOCK}},
+			}
+			payload, err := anypb.New(blk)
+			require.NoError(t, err)
+
+			out, err := f.Transform(&pbbstream.Block{Number: 10, Payload: payload}, nil)
+			require.NoError(t, err)
+
+			expected := proto.Clone(blk).(*pbeth.Block)
+			if test.expectMatches == 0 {
+				expected.TransactionTraces = nil
+			}
+			assertProtoEqual(t, expected, out.(*pbeth.Block))
+		})
+	}
+}
+
+func TestCombinedFilter_LogsBloomStateSync(t *testing.T) {
+	stateReceiver := eth.MustNewAddress("0x0000000000000000000000000000000000001001")
+	stateCommitted := eth.MustNewHash("0x103fed9db65eac19c4d870f49ab7520fe03b99f1838e5996caf47e9e43308392")
+
+	// like on Polygon, the state sync logs are not part of the header logs bloom
+	blk := &pbeth.Block{
+		Number: 10,
+		Header: &pbeth.BlockHeader{Number: 10, LogsBloom: testLogsBloom(tokenAddr)},
+		TransactionTraces: []*pbeth.TransactionTrace{{
+			Receipt: &pbeth.TransactionReceipt{Logs: []*pbeth.Log{{Address: stateReceiver, Topics: [][]byte{stateCommitted}}}},
+		}},
+	}
+	payload, err := anypb.New(blk)
+	require.NoError(t, err)
+
+	var index []*pbbstream.KeyToBitmap
+	for key, bitmap := range indexBlocks(blk) {
+		data, err := bitmap.ToBytes()
+		require.NoError(t, err)
+		index = append(index, &pbbstream.KeyToBitmap{Key: []byte(key), Bitmap: data})
+	}
+	indexFile, err := proto.Marshal(&pbbstream.GenericBlockIndex{Kv: index})
+	require.NoError(t, err)
+
+	indexStore := dstore.NewMockStore(nil)
+	indexStore.SetFile("0000000000.100."+CombinedIndexerShortName+".idx", indexFile)
+	indexStore.SetFile("0000000100.100."+CombinedIndexerShortName+".idx", indexFile)
+
+	tests := []struct {
+		name         string
+		useLogsBloom bool
+		// indexedRange is the base block of the range answered by the index, -1 when the index is not used
+		indexedRange int64
+		expectKept   bool
+	}{
+		{"bloom not used", false, -1, true},
+		{"bloom used without index", true, -1, false},
+		{"bloom used on indexed range", true, 0, true},
+		{"bloom used after indexed range", true, 100, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := newCombinedFilter(&pbtransform.CombinedFilter{LogFilters: []*pbtransform.LogFilter{{Addresses: [][]byte{stateReceiver}}}}, indexStore, []uint64{100})
+			require.NoError(t, err)
+			f.useLogsBloom = test.useLogsBloom
+
+			if test.indexedRange >= 0 {
+				_, err := f.GetIndexProvider().BlocksInRange(uint64(test.indexedRange), 100)
+				require.NoError(t, err)
+			}
+
+			out, err := f.Transform(&pbbstream.Block{Number: 10, Payload: payload}, nil)
+			require.NoError(t, err)
+			if test.expectKept {
+				assertProtoEqual(t, blk, out.(*pbeth.Block))
+			} else {
+				assert.Empty(t, out.(*pbeth.Block).TransactionTraces)
+			}
+		})
+	}
+}
+
+// testLogsBloom returns a block header logs bloom containing the given values
+func testLogsBloom(values ...[]byte) []byte {
+	bloom := make([]byte, logsBloomSize)
+	for _, value := range values {
+		hash := eth.Keccak256(value)
+		for i := 0; i < 6; i += 2 {
+			bit := binary.BigEndian.Uint16(hash[i:]) & 0x7ff
+			bloom[logsBloomSize-1-int(bit>>3)] |= 1 << (bit & 0x7)
+		}
+	}
+	return bloom
+}
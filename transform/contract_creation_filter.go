@@ -0,0 +1,130 @@
+package transform
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/eth-go"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+)
+
+type ContractCreationFilter struct {
+	deployers  []eth.Address
+	factories  []eth.Address
+	codeHashes []eth.Hash
+}
+
+func NewContractCreationFilter(in *pbtransform.ContractCreationFilter) (*ContractCreationFilter, error) {
+	f := &ContractCreationFilter{
+		deployers:  make([]eth.Address, len(in.Deployers)),
+		factories:  make([]eth.Address, len(in.Factories)),
+		codeHashes: make([]eth.Hash, len(in.CodeHashes)),
+	}
+	for i, addr := range in.Deployers {
+		f.deployers[i] = addr
+	}
+	for i, addr := range in.Factories {
+		f.factories[i] = addr
+	}
+	for i, hash := range in.CodeHashes {
+		if len(hash) != 32 {
+			return nil, fmt.Errorf("invalid code hash %x: expected 32 bytes, got %d", hash, len(hash))
+		}
+		f.codeHashes[i] = hash
+	}
+	return f, nil
+}
+
+func (f *ContractCreationFilter) String() string {
+	pretty := func(in []eth.Address) string {
+		out := make([]string, len(in))
+		for i, addr := range in {
+			out[i] = addr.Pretty()
+		}
+		return strings.Join(out, ",")
+	}
+
+	codeHashes := make([]string, len(f.codeHashes))
+	for i, hash := range f.codeHashes {
+		codeHashes[i] = hash.Pretty()
+	}
+
+	return fmt.Sprintf("{deployers: %s, factories: %s, code_hashes: %s}", pretty(f.deployers), pretty(f.factories), strings.Join(codeHashes, ","))
+}
+
+func (f *ContractCreationFilter) matches(trace *pbeth.TransactionTrace) bool {
+	if !matchAnyAddress(f.deployers, trace.From) {
+		return false
+	}
+
+	for _, call := range trace.Calls {
+		if call.CallType != pbeth.CallType_CREATE {
+			continue
+		}
+
+		if matchAnyAddress(f.factories, call.Caller) && f.matchCodeHash(call) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *ContractCreationFilter) matchCodeHash(call *pbeth.Call) bool {
+	if len(f.codeHashes) == 0 {
+		return true
+	}
+	for _, change := range call.CodeChanges {
+		if bytes.Equal(change.Address, call.Address) && matchAnyHash(f.codeHashes, change.NewHash) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *ContractCreationFilter) indexMarker() string {
+	return IdxKeyContractCreations
+}
+
+// bitmap finds the blockNums containing contract creations matching each of the provided
+// constraints, or all contract creations if the filter has no constraint
+func (f *ContractCreationFilter) bitmap(bitmaps transform.BitmapGetter) *roaring64.Bitmap {
+	out := roaring64.NewBitmap()
+	if bm := bitmaps.Get(IdxKeyHasContractCreation); bm != nil {
+		out.Or(bm)
+	}
+
+	if len(f.deployers) != 0 {
+		out.And(addressBitmap(f.deployers, bitmaps, IdxPrefixCreationDeployer))
+	}
+	if len(f.factories) != 0 {
+		out.And(addressBitmap(f.factories, bitmaps, IdxPrefixCreationFactory))
+	}
+	if len(f.codeHashes) != 0 {
+		out.And(sigsBitmap(f.codeHashes, bitmaps, IdxPrefixCreationCodeHash))
+	}
+	return out
+}
+
+func contractCreationKeys(trace *pbeth.TransactionTrace) map[string]bool {
+	out := make(map[string]bool)
+	for _, call := range trace.Calls {
+		if call.CallType != pbeth.CallType_CREATE {
+			continue
+		}
+
+		out[IdxKeyHasContractCreation] = true
+		out[IdxPrefixCreationDeployer+hex.EncodeToString(trace.From)] = true
+		out[IdxPrefixCreationFactory+hex.EncodeToString(call.Caller)] = true
+		for _, change := range call.CodeChanges {
+			if bytes.Equal(change.Address, call.Address) {
+				out[IdxPrefixCreationCodeHash+hex.EncodeToString(change.NewHash)] = true
+			}
+		}
+	}
+	return out
+}
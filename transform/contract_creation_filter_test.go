@@ -0,0 +1,49 @@
+package transform
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+)
+
+func TestContractCreationFilter(t *testing.T) {
+	codeHash := eth.MustNewHash("0x1111111111111111111111111111111111111111111111111111111111111111")
+	otherCodeHash := eth.MustNewHash("0x2222222222222222222222222222222222222222222222222222222222222222")
+	factory := eth.MustNewAddress("0xffffffffffffffffffffffffffffffffffffffff")
+
+	deployment := func(from, caller, created eth.Address, hash eth.Hash) *pbeth.TransactionTrace {
+		calls := []*pbeth.Call{{CallType: pbeth.CallType_CALL, Caller: from, Address: caller}}
+		if bytes.Equal(caller, from) {
+			calls = nil
+		}
+
+		calls = append(calls, &pbeth.Call{
+			CallType:    pbeth.CallType_CREATE,
+			Caller:      caller,
+			Address:     created,
+			CodeChanges: []*pbeth.CodeChange{{Address: created, NewHash: hash}},
+		})
+
+		return &pbeth.TransactionTrace{From: from, Receipt: &pbeth.TransactionReceipt{}, Calls: calls}
+	}
+
+	blocks := []*pbeth.Block{
+		{Number: 10, TransactionTraces: []*pbeth.TransactionTrace{deployment(senderA, senderA, tokenAddr, codeHash)}},
+		{Number: 11, TransactionTraces: []*pbeth.TransactionTrace{deployment(senderB, factory, recipient, codeHash)}},
+		{Number: 12, TransactionTraces: []*pbeth.TransactionTrace{deployment(senderA, factory, recipient, otherCodeHash)}},
+		{Number: 13, TransactionTraces: []*pbeth.TransactionTrace{{From: senderA, Receipt: &pbeth.TransactionReceipt{}, Calls: []*pbeth.Call{{CallType: pbeth.CallType_CALL, Caller: senderA}}}}},
+	}
+	testFilterKind(t, blocks, IdxKeyContractCreations, func(filter *pbtransform.ContractCreationFilter) *pbtransform.CombinedFilter {
+		return &pbtransform.CombinedFilter{ContractCreationFilters: []*pbtransform.ContractCreationFilter{filter}}
+	}, []filterKindTest[*pbtransform.ContractCreationFilter]{
+		{"any deployment", &pbtransform.ContractCreationFilter{}, []uint64{10, 11, 12}, []uint64{10, 11, 12}},
+		{"deployer", &pbtransform.ContractCreationFilter{Deployers: [][]byte{senderA}}, []uint64{10, 12}, []uint64{10, 12}},
+		{"factory", &pbtransform.ContractCreationFilter{Factories: [][]byte{factory}}, []uint64{11, 12}, []uint64{11, 12}},
+		{"code hash", &pbtransform.ContractCreationFilter{CodeHashes: [][]byte{codeHash}}, []uint64{10, 11}, []uint64{10, 11}},
+		{"factory and code hash", &pbtransform.ContractCreationFilter{Factories: [][]byte{factory}, CodeHashes: [][]byte{otherCodeHash}}, []uint64{12}, []uint64{12}},
+		{"no match", &pbtransform.ContractCreationFilter{Deployers: [][]byte{senderB}, CodeHashes: [][]byte{otherCodeHash}}, nil, nil},
+	})
+}
@@ -0,0 +1,215 @@
+package transform
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+)
+
+type expressionOp int
+
+const (
+	expressionLeaf expressionOp = iota
+	expressionAnd
+	expressionOr
+	expressionNot
+)
+
+// FilterExpression is a boolean composition (and, or, not) of filters matched against each transaction
+type FilterExpression struct {
+	op       expressionOp
+	children []*FilterExpression
+	leaf     traceFilter
+}
+
+func NewFilterExpression(in *pbtransform.FilterExpression) (*FilterExpression, error) {
+	switch expr := in.Expression.(type) {
+	case *pbtransform.FilterExpression_And:
+		return newFilterExpressionNode(expressionAnd, "and", expr.And)
+	case *pbtransform.FilterExpression_Or:
+		return newFilterExpressionNode(expressionOr, "or", expr.Or)
+	case *pbtransform.FilterExpression_Not:
+		if expr.Not == nil {
+			return nil, fmt.Errorf("a not expression requires an expression")
+		}
+		child, err := NewFilterExpression(expr.Not)
+		if err != nil {
+			return nil, err
+		}
+		return &FilterExpression{op: expressionNot, children: []*FilterExpression{child}}, nil
+
+	case *pbtransform.FilterExpression_LogFilter:
+		return newFilterExpressionLeaf(NewLogFilter(expr.LogFilter))
+	case *pbtransform.FilterExpression_CallFilter:
+		return newFilterExpressionLeaf(NewCallToFilter(expr.CallFilter))
+	case *pbtransform.FilterExpression_TransactionFilter:
+		return newFilterExpressionLeaf(NewTransactionFilter(expr.TransactionFilter))
+	case *pbtransform.FilterExpression_StorageChangeFilter:
+		return newFilterExpressionLeaf(NewStorageChangeFilter(expr.StorageChangeFilter))
+	case *pbtransform.FilterExpression_BalanceChangeFilter:
+		return newFilterExpressionLeaf(NewBalanceChangeFilter(expr.BalanceChangeFilter))
+	case *pbtransform.FilterExpression_ContractCreationFilter:
+		return newFilterExpressionLeaf(NewContractCreationFilter(expr.ContractCreationFilter))
+	case *pbtransform.FilterExpression_ValueTransferFilter:
+		return newFilterExpressionLeaf(NewValueTransferFilter(expr.ValueTransferFilter))
+	case *pbtransform.FilterExpression_BlobFilter:
+		return newFilterExpressionLeaf(NewBlobFilter(expr.BlobFilter))
+	case *pbtransform.FilterExpression_SetCodeFilter:
+		return newFilterExpressionLeaf(NewSetCodeFilter(expr.SetCodeFilter))
+	}
+
+	return nil, fmt.Errorf("a filter expression requires one of and, or, not or a filter")
+}
+
+func newFilterExpressionNode(op expressionOp, name string, in *pbtransform.FilterExpressions) (*FilterExpression, error) {
+	if in == nil || len(in.Expressions) == 0 {
+		return nil, fmt.Errorf("an %s expression requires at-least one expression", name)
+	}
+
+	out := &FilterExpression{op: op, children: make([]*FilterExpression, len(in.Expressions))}
+	for i, in := range in.Expressions {
+		child, err := NewFilterExpression(in)
+		if err != nil {
+			return nil, err
+		}
+		out.children[i] = child
+	}
+	return out, nil
+}
+
+func newFilterExpressionLeaf[T traceFilter](leaf T, err error) (*FilterExpression, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &FilterExpression{op: expressionLeaf, leaf: leaf}, nil
+}
+
+// visitLeaves calls fn on each filter of the expression
+func (e *FilterExpression) visitLeaves(fn func(leaf traceFilter)) {
+	if e.op == expressionLeaf {
+		fn(e.leaf)
+		return
+	}
+	for _, child := range e.children {
+		child.visitLeaves(fn)
+	}
+}
+
+// hasLeaf returns true when one of the filters of the expression satisfies fn
+func (e *FilterExpression) hasLeaf(fn func(leaf traceFilter) bool) (found bool) {
+	e.visitLeaves(func(leaf traceFilter) {
+		found = found || fn(leaf)
+	})
+	return found
+}
+
+func (e *FilterExpression) String() string {
+	if e.op == expressionLeaf {
+		return filterString(e.leaf, 5)
+	}
+
+	children := make([]string, len(e.children))
+	for i, child := range e.children {
+		children[i] = child.String()
+	}
+
+	name := map[expressionOp]string{expressionAnd: "and", expressionOr: "or", expressionNot: "not"}[e.op]
+	return fmt.Sprintf("%s(%s)", name, strings.Join(children, ","))
+}
+
+func (e *FilterExpression) matches(trace *pbeth.TransactionTrace) bool {
+	switch e.op {
+	case expressionAnd:
+		for _, child := range e.children {
+			if !child.matches(trace) {
+				return false
+			}
+		}
+		return true
+	case expressionOr:
+		for _, child := range e.children {
+			if child.matches(trace) {
+				return true
+			}
+		}
+		return false
+	case expressionNot:
+		return !e.children[0].matches(trace)
+	default:
+		return e.leaf.matches(trace)
+	}
+}
+
+// mayMatchLogsBloom returns false when the block having this logs bloom certainly contains no transaction
+// matching the expression, only log filters can be evaluated against the bloom, other filters and `not`
+// expressions are assumed to possibly match.
+func (e *FilterExpression) mayMatchLogsBloom(bloom []byte) bool {
+	switch e.op {
+	case expressionAnd:
+		for _, child := range e.children {
+			if !child.mayMatchLogsBloom(bloom) {
+				return false
+			}
+		}
+		return true
+	case expressionOr:
+		for _, child := range e.children {
+			if child.mayMatchLogsBloom(bloom) {
+				return true
+			}
+		}
+		return false
+	case expressionNot:
+		return true
+	default:
+		if lf, ok := e.leaf.(*LogFilter); ok {
+			return lf.mayMatchLogsBloom(bloom)
+		}
+		return true
+	}
+}
+
+// bitmap finds the blockNums which may contain a transaction matching the expression, supported is false
+// when the index doesn't contain the keys required to evaluate it.
+//
+// The index only tells which blocks contain *a* transaction matching a filter, a block in a filter's bitmap
+// can also contain transactions not matching it, so a `not` expression resolves to all the blocks
+// containing at least one transaction.
+func (e *FilterExpression) bitmap(bitmaps transform.BitmapGetter) (out *roaring64.Bitmap, supported bool) {
+	switch e.op {
+	case expressionAnd, expressionOr:
+		for _, child := range e.children {
+			bm, supported := child.bitmap(bitmaps)
+			if !supported {
+				return nil, false
+			}
+
+			switch {
+			case out == nil:
+				// the child bitmap may be one of the index bitmaps, it must not be modified
+				out = bm.Clone()
+			case e.op == expressionAnd:
+				out.And(bm)
+			default:
+				out.Or(bm)
+			}
+		}
+		return out, true
+
+	case expressionNot:
+		if _, supported := e.children[0].bitmap(bitmaps); !supported {
+			return nil, false
+		}
+		if bitmaps.Get(IdxKeyTransactions) == nil {
+			return nil, false
+		}
+		return transactionsBitmap(bitmaps), true
+
+	default:
+		return indexBitmap(e.leaf, bitmaps)
+	}
+}
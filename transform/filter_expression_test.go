@@ -0,0 +1,161 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterExpression(t *testing.T) {
+	router := eth.MustNewAddress("0x1111111111111111111111111111111111111111")
+	pair := eth.MustNewAddress("0x2222222222222222222222222222222222222222")
+	syncSig := eth.MustNewHash("0x1c411e9a96e071241c2f21f7726b17ae89e3cab4c78be50e062b03a9fffbbad1")
+	swapSig := eth.MustNewHash("0xd78ad95fa46c994b6551d0da85fc275fe613ce37657fb8d5e3d130840159d822")
+
+	routerCall := func(from eth.Address, logSigs ...eth.Hash) *pbeth.TransactionTrace {
+		logs := make([]*pbeth.Log, len(logSigs))
+		for i, sig := range logSigs {
+			logs[i] = &pbeth.Log{Address: pair, Topics: [][]byte{sig}}
+		}
+		return &pbeth.TransactionTrace{
+			From:    from,
+			To:      router,
+			Status:  pbeth.TransactionTraceStatus_SUCCEEDED,
+			Receipt: &pbeth.TransactionReceipt{Logs: logs},
+			Calls:   []*pbeth.Call{{CallType: pbeth.CallType_CALL, Caller: from, Address: router}},
+		}
+	}
+
+	blocks := []*pbeth.Block{
+		{Number: 10, TransactionTraces: []*pbeth.TransactionTrace{routerCall(senderA, swapSig)}},
+		{Number: 11, TransactionTraces: []*pbeth.TransactionTrace{routerCall(senderA, syncSig)}},
+		{Number: 12, TransactionTraces: []*pbeth.TransactionTrace{{From: senderB, To: recipient, Receipt: &pbeth.TransactionReceipt{}}}},
+		{Number: 13, TransactionTraces: []*pbeth.TransactionTrace{routerCall(senderB, syncSig), routerCall(senderB)}},
+	}
+	bitmaps := indexBlocks(blocks...)
+
+	callToRouter := &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_CallFilter{CallFilter: &pbtransform.CallToFilter{Addresses: [][]byte{router}}}}
+	syncFromPair := &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_LogFilter{LogFilter: &pbtransform.LogFilter{Addresses: [][]byte{pair}, EventSignatures: [][]byte{syncSig}}}}
+	swapFromPair := &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_LogFilter{LogFilter: &pbtransform.LogFilter{Addresses: [][]byte{pair}, EventSignatures: [][]byte{swapSig}}}}
+	fromSenderB := &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_TransactionFilter{TransactionFilter: &pbtransform.TransactionFilter{From: [][]byte{senderB}}}}
+
+	and := func(in ...*pbtransform.FilterExpression) *pbtransform.FilterExpression {
+		return &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_And{And: &pbtransform.FilterExpressions{Expressions: in}}}
+	}
+	or := func(in ...*pbtransform.FilterExpression) *pbtransform.FilterExpression {
+		return &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_Or{Or: &pbtransform.FilterExpressions{Expressions: in}}}
+	}
+	not := func(in *pbtransform.FilterExpression) *pbtransform.FilterExpression {
+		return &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_Not{Not: in}}
+	}
+
+	tests := []struct {
+		name          string
+		expression    *pbtransform.FilterExpression
+		expectTrace   []uint64
+		expectIndexed []uint64
+	}{
+		{"leaf", callToRouter, []uint64{10, 11, 13, 13}, []uint64{10, 11, 13}},
+		{"and across kinds", and(callToRouter, fromSenderB), []uint64{13, 13}, []uint64{13}},
+		{"or", or(swapFromPair, fromSenderB), []uint64{10, 12, 13, 13}, []uint64{10, 12, 13}},
+		{"and not", and(callToRouter, not(syncFromPair)), []uint64{10, 13}, []uint64{10, 11, 13}},
+		{"not", not(callToRouter), []uint64{12}, []uint64{10, 11, 12, 13}},
+		{"nested", or(and(callToRouter, not(or(syncFromPair, swapFromPair))), swapFromPair), []uint64{10, 13}, []uint64{10, 11, 13}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := newCombinedFilter(&pbtransform.CombinedFilter{Expression: test.expression}, nil, nil)
+			require.NoError(t, err)
+
+			var matching []uint64
+			for _, blk := range blocks {
+				for _, trace := range blk.TransactionTraces {
+					if f.matches(trace) {
+						matching = append(matching, blk.Number)
+					}
+				}
+			}
+			assert.Equal(t, test.expectTrace, matching)
+
+			out, supported := f.indexedBlocks(bitmaps)
+			require.True(t, supported)
+			assert.Equal(t, test.expectIndexed, out)
+		})
+	}
+
+	t.Run("legacy index", func(t *testing.T) {
+		f, err := newCombinedFilter(&pbtransform.CombinedFilter{Expression: and(callToRouter, not(syncFromPair))}, nil, nil)
+		require.NoError(t, err)
+
+		_, supported := f.indexedBlocks(bitmaps.without(IdxKeyTransactions))
+		assert.False(t, supported, "index without transaction keys cannot resolve a not expression")
+	})
+}
+
+func TestFilterExpression_BitmapLeavesIndexUntouched(t *testing.T) {
+	router := eth.MustNewAddress("0x1111111111111111111111111111111111111111")
+	blocks := []*pbeth.Block{
+		{Number: 10, TransactionTraces: []*pbeth.TransactionTrace{{From: senderA, To: router, Receipt: &pbeth.TransactionReceipt{}}}},
+		{Number: 11, TransactionTraces: []*pbeth.TransactionTrace{{From: senderB, To: router, Receipt: &pbeth.TransactionReceipt{}}}},
+		{Number: 12, TransactionTraces: []*pbeth.TransactionTrace{{From: senderB, To: recipient, Receipt: &pbeth.TransactionReceipt{}}}},
+	}
+	bitmaps := indexBlocks(blocks...)
+	before := make(map[string][]uint64, len(bitmaps))
+	for key, bm := range bitmaps {
+		before[key] = bm.ToArray()
+	}
+
+	transactionFilter := func(filter *pbtransform.TransactionFilter) *pbtransform.FilterExpression {
+		return &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_TransactionFilter{TransactionFilter: filter}}
+	}
+	expression, err := NewFilterExpression(&pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_And{And: &pbtransform.FilterExpressions{Expressions: []*pbtransform.FilterExpression{
+		{Expression: &pbtransform.FilterExpression_Or{Or: &pbtransform.FilterExpressions{Expressions: []*pbtransform.FilterExpression{
+			transactionFilter(&pbtransform.TransactionFilter{To: [][]byte{router}}),
+			transactionFilter(&pbtransform.TransactionFilter{To: [][]byte{recipient}}),
+		}}}},
+		transactionFilter(&pbtransform.TransactionFilter{From: [][]byte{senderB}}),
+	}}}})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		out, supported := expression.bitmap(bitmaps)
+		require.True(t, supported)
+		assert.Equal(t, []uint64{11, 12}, out.ToArray(), "evaluation %d", i)
+	}
+
+	for key, bm := range bitmaps {
+		assert.Equal(t, before[key], bm.ToArray(), "index bitmap %q was modified", key)
+	}
+}
+
+func TestFilterExpression_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression *pbtransform.FilterExpression
+	}{
+		{"empty", &pbtransform.FilterExpression{}},
+		{"empty and", &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_And{And: &pbtransform.FilterExpressions{}}}},
+		{"empty not", &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_Not{}}},
+		{"invalid leaf", &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_LogFilter{LogFilter: &pbtransform.LogFilter{}}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewFilterExpression(test.expression)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestFilterExpression_String(t *testing.T) {
+	f, err := NewFilterExpression(&pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_Not{Not: &pbtransform.FilterExpression{
+		Expression: &pbtransform.FilterExpression_CallFilter{CallFilter: &pbtransform.CallToFilter{Addresses: [][]byte{eth.MustNewHex("0xdeadbeef")}}},
+	}}})
+	require.NoError(t, err)
+	assert.Equal(t, "not({addrs: 0xdeadbeef, sigs: })", f.String())
+}
@@ -1,7 +1,10 @@
 package transform
 
 import (
+	"bytes"
 	"fmt"
+
+	"github.com/streamingfast/eth-go"
 )
 
 func lowBoundary(i uint64, mod uint64) uint64 {
@@ -11,3 +14,29 @@ func lowBoundary(i uint64, mod uint64) uint64 {
 func toIndexFilename(bundleSize, baseBlockNum uint64, shortname string) string {
 	return fmt.Sprintf("%010d.%d.%s.idx", baseBlockNum, bundleSize, shortname)
 }
+
+// matchAnyAddress returns true if src is one of the addresses, or if addresses is empty
+func matchAnyAddress(addresses []eth.Address, src []byte) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	for _, addr := range addresses {
+		if bytes.Equal(addr, src) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchAnyHash returns true if src is one of the hashes, or if hashes is empty
+func matchAnyHash(hashes []eth.Hash, src []byte) bool {
+	if len(hashes) == 0 {
+		return true
+	}
+	for _, hash := range hashes {
+		if bytes.Equal(hash, src) {
+			return true
+		}
+	}
+	return false
+}
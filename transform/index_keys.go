@@ -0,0 +1,163 @@
+package transform
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+)
+
+const IndexKeyKindMarker = "marker"
+const IndexKeyKindUnknown = "unknown"
+
+// IndexFeature is a group of keys of the combined index, the index files containing them have the
+// Marker key set for all their blocks
+type IndexFeature struct {
+	Marker string
+	Name   string
+}
+
+// IndexFeatures lists the features of the combined index which were added over time, index files produced
+// by older versions miss some of them
+var IndexFeatures = []IndexFeature{
+	{IdxKeyLogTopics, "log topics"},
+	{IdxKeyCallDepthsAndTypes, "call depths and types"},
+	{IdxKeyCallCallers, "call callers"},
+	{IdxKeyTransactions, "transactions"},
+	{IdxKeyStorageChanges, "storage changes"},
+	{IdxKeyBalanceChanges, "balance changes"},
+	{IdxKeyContractCreations, "contract creations"},
+	{IdxKeyValueTransfers, "value transfers"},
+	{IdxKeyBlobs, "blob transactions"},
+	{IdxKeySetCodes, "set code authorizations"},
+}
+
+// indexKey describes the keys written by the EthCombinedIndexer, either a whole key (exact) or a prefix
+// followed by a value
+type indexKey struct {
+	key   string
+	exact bool
+	kind  string
+	// decode returns the kind and value of the keys whose kind depends on their value, nil when the
+	// value is kept as is
+	decode func(value string) (string, string)
+	// marker is the IndexFeature marker of the key, empty for the keys written by all versions (call
+	// and log addresses and signatures)
+	marker string
+}
+
+// indexKeys is the registry of the combined index keys, the exact keys come first then the prefixes, longest
+// first so that they are matched before the shorter prefixes they start with
+var indexKeys = sortIndexKeys(append(featureIndexKeys(IndexFeatures), []indexKey{
+	{key: IdxKeyHasContractCreation, exact: true, kind: "contract creation", marker: IdxKeyContractCreations},
+	{key: IdxKeyHasValueTransfer, exact: true, kind: "value transfer", marker: IdxKeyValueTransfers},
+	{key: IdxKeyHasBlobTransaction, exact: true, kind: "blob transaction", marker: IdxKeyBlobs},
+	{key: IdxKeyHasSetCode, exact: true, kind: "set code authorization", marker: IdxKeySetCodes},
+
+	{key: IdxPrefixLog, decode: addressOrHashKind("log address", "log signature")},
+	{key: logTopicPrefix(IdxPrefixLog, 1), decode: hexKind("log topic1"), marker: IdxKeyLogTopics},
+	{key: logTopicPrefix(IdxPrefixLog, 2), decode: hexKind("log topic2"), marker: IdxKeyLogTopics},
+	{key: logTopicPrefix(IdxPrefixLog, 3), decode: hexKind("log topic3"), marker: IdxKeyLogTopics},
+	{key: IdxPrefixCall, decode: addressOrHashKind("call address", "call signature")},
+	{key: IdxPrefixCallTopLevel, decode: addressOrHashKind("top-level call address", "top-level call signature"), marker: IdxKeyCallDepthsAndTypes},
+	{key: IdxPrefixCallType, decode: callTypeKind, marker: IdxKeyCallDepthsAndTypes},
+	{key: IdxPrefixCallCaller, decode: hexKind("call caller"), marker: IdxKeyCallCallers},
+	{key: IdxPrefixTrxFrom, decode: hexKind("transaction from"), marker: IdxKeyTransactions},
+	{key: IdxPrefixTrxTo, decode: hexKind("transaction to"), marker: IdxKeyTransactions},
+	{key: IdxPrefixTrxType, kind: "transaction type", marker: IdxKeyTransactions},
+	{key: IdxPrefixTrxStatus, kind: "transaction status", marker: IdxKeyTransactions},
+	{key: IdxPrefixStorage, decode: addressOrHashKind("storage change address", "storage change key"), marker: IdxKeyStorageChanges},
+	{key: IdxPrefixBalance, decode: hexKind("balance change address"), marker: IdxKeyBalanceChanges},
+	{key: IdxPrefixBalanceReason, kind: "balance change reason", marker: IdxKeyBalanceChanges},
+	{key: IdxPrefixCreationDeployer, decode: hexKind("contract creation deployer"), marker: IdxKeyContractCreations},
+	{key: IdxPrefixCreationFactory, decode: hexKind("contract creation factory"), marker: IdxKeyContractCreations},
+	{key: IdxPrefixCreationCodeHash, decode: hexKind("contract creation code hash"), marker: IdxKeyContractCreations},
+	{key: IdxPrefixValueFrom, decode: hexKind("value transfer from"), marker: IdxKeyValueTransfers},
+	{key: IdxPrefixValueTo, decode: hexKind("value transfer to"), marker: IdxKeyValueTransfers},
+	{key: IdxPrefixBlobSender, decode: hexKind("blob transaction from"), marker: IdxKeyBlobs},
+	{key: IdxPrefixBlobHash, decode: hexKind("blob hash"), marker: IdxKeyBlobs},
+	{key: IdxPrefixSetCodeAuthority, decode: hexKind("set code authority"), marker: IdxKeySetCodes},
+	{key: IdxPrefixSetCodeAddress, decode: hexKind("set code address"), marker: IdxKeySetCodes},
+}...))
+
+func featureIndexKeys(features []IndexFeature) []indexKey {
+	out := make([]indexKey, len(features))
+	for i, feature := range features {
+		out[i] = indexKey{key: feature.Marker, exact: true, kind: IndexKeyKindMarker, marker: feature.Marker}
+	}
+	return out
+}
+
+func sortIndexKeys(keys []indexKey) []indexKey {
+	sort.SliceStable(keys, func(i, j int) bool {
+		if keys[i].exact != keys[j].exact {
+			return keys[i].exact
+		}
+		return len(keys[i].key) > len(keys[j].key)
+	})
+	return keys
+}
+
+func lookupIndexKey(key string) (entry indexKey, value string, found bool) {
+	for _, candidate := range indexKeys {
+		if candidate.exact && key == candidate.key || !candidate.exact && strings.HasPrefix(key, candidate.key) {
+			return candidate, key[len(candidate.key):], true
+		}
+	}
+	return indexKey{}, "", false
+}
+
+// IndexKeyKind returns the kind of a key written by the EthCombinedIndexer (like "log address" or
+// "call signature") along with the value it holds, `0x` prefixed when it's an address or a hash.
+// The keys flagging which features an index file contains are of kind IndexKeyKindMarker and
+// keys this version doesn't produce are of kind IndexKeyKindUnknown.
+func IndexKeyKind(key string) (kind string, value string) {
+	entry, value, found := lookupIndexKey(key)
+	switch {
+	case !found:
+		return IndexKeyKindUnknown, key
+	case entry.kind == IndexKeyKindMarker:
+		return IndexKeyKindMarker, key
+	case entry.decode != nil:
+		return entry.decode(value)
+	}
+	return entry.kind, value
+}
+
+func hexKind(kind string) func(value string) (string, string) {
+	return func(value string) (string, string) {
+		return kind, "0x" + value
+	}
+}
+
+// addressOrHashKind tells apart the keys sharing a prefix for addresses and hashes (or method ids) using
+// the length of their hex value
+func addressOrHashKind(addressKind, hashKind string) func(value string) (string, string) {
+	return func(value string) (string, string) {
+		if len(value) == 40 {
+			return addressKind, "0x" + value
+		}
+		return hashKind, "0x" + value
+	}
+}
+
+// IndexKeyMarker returns the marker key that index files contain when they were produced by a version
+// writing the given key, an empty string for the keys written by all versions (call and log addresses
+// and signatures).
+func IndexKeyMarker(key string) string {
+	entry, _, _ := lookupIndexKey(key)
+	return entry.marker
+}
+
+// callTypeKind decodes the `<type>:<address or signature>` values of the keys under IdxPrefixCallType
+func callTypeKind(value string) (string, string) {
+	number, value, found := strings.Cut(value, callTypeSeparator)
+	callType, err := strconv.ParseInt(number, 10, 32)
+	if !found || err != nil {
+		return IndexKeyKindUnknown, IdxPrefixCallType + number
+	}
+
+	name := pbeth.CallType(callType).String()
+	return addressOrHashKind(name+" call address", name+" call signature")(value)
+}
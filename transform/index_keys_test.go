@@ -0,0 +1,129 @@
+package transform
+
+import (
+	"sort"
+	"testing"
+
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexKeyKind(t *testing.T) {
+	bitmaps := indexBlocks(&pbeth.Block{
+		Number: 10,
+		TransactionTraces: []*pbeth.TransactionTrace{{
+			From:                  senderA,
+			To:                    tokenAddr,
+			Status:                pbeth.TransactionTraceStatus_SUCCEEDED,
+			BlobHashes:            [][]byte{priceSlot},
+			SetCodeAuthorizations: []*pbeth.SetCodeAuthorization{{Address: recipient, Authority: senderA}},
+			Receipt: &pbeth.TransactionReceipt{Logs: []*pbeth.Log{
+				{Address: tokenAddr, Topics: [][]byte{transferSig, walletA}},
+			}},
+			Calls: []*pbeth.Call{{
+				CallType:       pbeth.CallType_CALL,
+				Caller:         senderA,
+				Address:        tokenAddr,
+				Input:          []byte{0xa9, 0x05, 0x9c, 0xbb},
+				Value:          pbeth.NewBigInt(1),
+				StorageChanges: []*pbeth.StorageChange{{Address: tokenAddr, Key: priceSlot}},
+				BalanceChanges: []*pbeth.BalanceChange{{Address: senderA, Reason: pbeth.BalanceChange_REASON_GAS_BUY}},
+			}},
+		}},
+	})
+
+	var kinds []string
+	for key := range bitmaps {
+		kind, value := IndexKeyKind(key)
+		kinds = append(kinds, kind+" "+value)
+	}
+	sort.Strings(kinds)
+
+	assert.Equal(t, []string{
+		"CALL call address 0xcccccccccccccccccccccccccccccccccccccccc",
+		"CALL call signature 0xa9059cbb",
+		"balance change address 0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"balance change reason 7",
+		"blob hash 0x0000000000000000000000000000000000000000000000000000000000000003",
+		"blob transaction ",
+		"blob transaction from 0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"call address 0xcccccccccccccccccccccccccccccccccccccccc",
+		"call caller 0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"call signature 0xa9059cbb",
+		"log address 0xcccccccccccccccccccccccccccccccccccccccc",
+		"log signature 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+		"log topic1 0x000000000000000000000000aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"marker B",
+		"marker CD",
+		"marker CF",
+		"marker D",
+		"marker K",
+		"marker LT",
+		"marker S",
+		"marker T",
+		"marker V",
+		"marker X",
+		"set code address 0xdddddddddddddddddddddddddddddddddddddddd",
+		"set code authority 0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"set code authorization ",
+		"storage change address 0xcccccccccccccccccccccccccccccccccccccccc",
+		"storage change key 0x0000000000000000000000000000000000000000000000000000000000000003",
+		"top-level call address 0xcccccccccccccccccccccccccccccccccccccccc",
+		"top-level call signature 0xa9059cbb",
+		"transaction from 0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"transaction status 1",
+		"transaction to 0xcccccccccccccccccccccccccccccccccccccccc",
+		"transaction type 0",
+		"value transfer ",
+		"value transfer from 0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"value transfer to 0xcccccccccccccccccccccccccccccccccccccccc",
+	}, kinds)
+
+	kind, _ := IndexKeyKind("Zsomething")
+	assert.Equal(t, IndexKeyKindUnknown, kind)
+}
+
+func TestIndexKeyMarker(t *testing.T) {
+	assert.Equal(t, "", IndexKeyMarker(IdxPrefixLog+"cccccccccccccccccccccccccccccccccccccccc"))
+	assert.Equal(t, "", IndexKeyMarker(IdxPrefixCall+"a9059cbb"))
+	assert.Equal(t, IdxKeyLogTopics, IndexKeyMarker(logTopicPrefix(IdxPrefixLog, 2)+"aa"))
+	assert.Equal(t, IdxKeyCallDepthsAndTypes, IndexKeyMarker(callTypePrefix(pbeth.CallType_DELEGATE)+"a9059cbb"))
+	assert.Equal(t, IdxKeyCallDepthsAndTypes, IndexKeyMarker(IdxPrefixCallTopLevel+"a9059cbb"))
+	assert.Equal(t, IdxKeyCallCallers, IndexKeyMarker(IdxPrefixCallCaller+"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"))
+	assert.Equal(t, IdxKeyTransactions, IndexKeyMarker(IdxPrefixTrxStatus+"1"))
+	assert.Equal(t, IdxKeyValueTransfers, IndexKeyMarker(IdxKeyHasValueTransfer))
+	assert.Equal(t, IdxKeySetCodes, IndexKeyMarker(IdxKeyHasSetCode))
+	assert.Equal(t, IdxKeyBlobs, IndexKeyMarker(IdxPrefixBlobHash+"0000000000000000000000000000000000000000000000000000000000000003"))
+	assert.Equal(t, IdxKeyTransactions, IndexKeyMarker(IdxKeyTransactions))
+	assert.Equal(t, IdxKeyStorageChanges, IndexKeyMarker(IdxPrefixStorage+"cccccccccccccccccccccccccccccccccccccccc"))
+	assert.Equal(t, IdxKeyBalanceChanges, IndexKeyMarker(IdxPrefixBalanceReason+"7"))
+	assert.Equal(t, IdxKeyContractCreations, IndexKeyMarker(IdxKeyHasContractCreation))
+}
+
+func TestIndexKeysMarkers(t *testing.T) {
+	features := make(map[string]bool)
+	for _, feature := range IndexFeatures {
+		features[feature.Marker] = true
+	}
+
+	for _, entry := range indexKeys {
+		if entry.marker != "" {
+			assert.True(t, features[entry.marker], "key %q has marker %q which is not an index feature", entry.key, entry.marker)
+		}
+	}
+}
+
+func TestIndexKeyKind_CallTypes(t *testing.T) {
+	kind, value := IndexKeyKind(callTypePrefix(pbeth.CallType_DELEGATE) + "a9059cbb")
+	assert.Equal(t, "DELEGATE call signature", kind)
+	assert.Equal(t, "0xa9059cbb", value)
+
+	// call types are not limited to a single digit
+	kind, value = IndexKeyKind(callTypePrefix(pbeth.CallType(12)) + "cccccccccccccccccccccccccccccccccccccccc")
+	assert.Equal(t, "12 call address", kind)
+	assert.Equal(t, "0xcccccccccccccccccccccccccccccccccccccccc", value)
+	assert.NotEqual(t, callTypePrefix(pbeth.CallType(1))+"2", callTypePrefix(pbeth.CallType(12)))
+
+	kind, _ = IndexKeyKind(IdxPrefixCallType + "1a9059cbb")
+	assert.Equal(t, IndexKeyKindUnknown, kind)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/streamingfast/bstream/transform"
 	"github.com/streamingfast/dstore"
 	"github.com/streamingfast/eth-go"
@@ -18,6 +19,12 @@ var MultiLogFilterMessageName = proto.MessageName(&pbtransform.MultiLogFilter{})
 type LogFilter struct {
 	addresses       []eth.Address
 	eventSignatures []eth.Hash
+
+	// topics holds the accepted values for topic.1, topic.2 and topic.3 respectively
+	topics [3][]eth.Hash
+
+	// ignoreReverted skips the logs of failed or reverted transactions
+	ignoreReverted bool
 }
 
 func (f *LogFilter) Addresses() []eth.Address {
@@ -28,9 +35,27 @@ func (f *LogFilter) Signatures() []eth.Hash {
 	return f.eventSignatures
 }
 
+// Topics returns the accepted values for the topic at the given position (1, 2 or 3), an
+// empty list means that any value is accepted at this position.
+func (f *LogFilter) Topics(position int) []eth.Hash {
+	if position < 1 || position > len(f.topics) {
+		return nil
+	}
+	return f.topics[position-1]
+}
+
+func (f *LogFilter) hasTopics() bool {
+	for _, topics := range f.topics {
+		if len(topics) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func NewLogFilter(in *pbtransform.LogFilter) (*LogFilter, error) {
-	if len(in.Addresses) == 0 && len(in.EventSignatures) == 0 {
-		return nil, fmt.Errorf("a log filter transform requires at-least one address or one event signature")
+	if len(in.Addresses) == 0 && len(in.EventSignatures) == 0 && len(in.Topic1) == 0 && len(in.Topic2) == 0 && len(in.Topic3) == 0 {
+		return nil, fmt.Errorf("a log filter transform requires at-least one address, one event signature or one topic")
 	}
 
 	f := &LogFilter{
@@ -43,6 +68,14 @@ func NewLogFilter(in *pbtransform.LogFilter) (*LogFilter, error) {
 	for i, sig := range in.EventSignatures {
 		f.eventSignatures[i] = sig
 	}
+	for i, topics := range [][][]byte{in.Topic1, in.Topic2, in.Topic3} {
+		for _, topic := range topics {
+			if len(topic) != 32 {
+				return nil, fmt.Errorf("invalid topic%d %x: expected 32 bytes, got %d", i+1, topic, len(topic))
+			}
+			f.topics[i] = append(f.topics[i], topic)
+		}
+	}
 	return f, nil
 }
 
@@ -73,15 +106,68 @@ func (p *LogFilter) matchEventSignature(topics [][]byte) bool {
 	return false
 }
 
+func (p *LogFilter) matchTopics(topics [][]byte) bool {
+	for i, accepted := range p.topics {
+		if len(accepted) == 0 {
+			continue
+		}
+		position := i + 1
+		if len(topics) <= position {
+			return false
+		}
+
+		found := false
+		for _, topic := range accepted {
+			if bytes.Equal(topic, topics[position]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *LogFilter) matchLog(log *pbeth.Log) bool {
+	return p.matchAddress(log.Address) && p.matchEventSignature(log.Topics) && p.matchTopics(log.Topics)
+}
+
 func (p *LogFilter) matches(trace *pbeth.TransactionTrace) bool {
+	if p.ignoreReverted && traceFailed(trace) {
+		return false
+	}
 	for _, log := range trace.Receipt.Logs {
-		if p.matchAddress(log.Address) && p.matchEventSignature(log.Topics) {
+		if p.matchLog(log) {
 			return true
 		}
 	}
 	return false
 }
 
+// mayMatchLogsBloom returns false when the block having this logs bloom certainly contains no log matching the filter
+func (p *LogFilter) mayMatchLogsBloom(bloom []byte) bool {
+	if !logsBloomMayContainAny(bloom, p.addresses) || !logsBloomMayContainAny(bloom, p.eventSignatures) {
+		return false
+	}
+	for _, topics := range p.topics {
+		if !logsBloomMayContainAny(bloom, topics) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *LogFilter) indexMarker() string {
+	// index files produced before topics were indexed are used for addresses and signatures
+	return ""
+}
+
+func (p *LogFilter) bitmap(bitmaps transform.BitmapGetter) *roaring64.Bitmap {
+	return logFilterBitmap(p, bitmaps, IdxPrefixLog)
+}
+
 func NewMultiLogFilterTransformFactory(indexStore dstore.Store, possibleIndexSizes []uint64) (*transform.Factory, error) {
 	return MultiLogFilterTransformFactory(indexStore, possibleIndexSizes), nil
 }
@@ -101,7 +187,7 @@ func MultiLogFilterTransformFactory(indexStore dstore.Store, possibleIndexSizes
 			if err != nil {
 				return nil, fmt.Errorf("unexpected unmarshall error: %w", err)
 			}
-			return newCombinedFilter(nil, filter.LogFilters, indexStore, possibleIndexSizes, false)
+			return newCombinedFilter(&pbtransform.CombinedFilter{LogFilters: filter.LogFilters}, indexStore, possibleIndexSizes)
 		},
 	}
 }
@@ -0,0 +1,97 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	transferSig = eth.MustNewHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	walletA     = eth.MustNewHash("0x000000000000000000000000aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	walletB     = eth.MustNewHash("0x000000000000000000000000bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	tokenAddr   = eth.MustNewAddress("0xcccccccccccccccccccccccccccccccccccccccc")
+)
+
+func TestLogFilter_Topics(t *testing.T) {
+	trace := &pbeth.TransactionTrace{
+		Receipt: &pbeth.TransactionReceipt{
+			Logs: []*pbeth.Log{
+				{Address: tokenAddr, Topics: [][]byte{transferSig, walletA, walletB}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		filter *pbtransform.LogFilter
+		expect bool
+	}{
+		{"topic1 only", &pbtransform.LogFilter{Topic1: [][]byte{walletA}}, true},
+		{"topic2 only", &pbtransform.LogFilter{Topic2: [][]byte{walletB}}, true},
+		{"topic2 mismatch", &pbtransform.LogFilter{Topic2: [][]byte{walletA}}, false},
+		{"topic2 one of", &pbtransform.LogFilter{Topic2: [][]byte{walletA, walletB}}, true},
+		{"topic3 absent", &pbtransform.LogFilter{Topic3: [][]byte{walletA}}, false},
+		{"sig and topic2", &pbtransform.LogFilter{EventSignatures: [][]byte{transferSig}, Topic2: [][]byte{walletB}}, true},
+		{"address and topic mismatch", &pbtransform.LogFilter{Addresses: [][]byte{tokenAddr}, Topic1: [][]byte{walletB}}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := NewLogFilter(test.filter)
+			require.NoError(t, err)
+			assert.Equal(t, test.expect, f.matches(trace))
+		})
+	}
+}
+
+func TestLogFilter_InvalidTopic(t *testing.T) {
+	_, err := NewLogFilter(&pbtransform.LogFilter{Topic1: [][]byte{tokenAddr}})
+	require.Error(t, err)
+}
+
+func TestCombinedFilter_TopicsBitmap(t *testing.T) {
+	transfer := func(from, to eth.Hash) *pbeth.TransactionTrace {
+		return &pbeth.TransactionTrace{Receipt: &pbeth.TransactionReceipt{Logs: []*pbeth.Log{
+			{Address: tokenAddr, Topics: [][]byte{transferSig, from, to}},
+		}}}
+	}
+
+	bitmaps := indexBlocks(
+		&pbeth.Block{Number: 10, TransactionTraces: []*pbeth.TransactionTrace{transfer(walletA, walletB)}},
+		&pbeth.Block{Number: 11, TransactionTraces: []*pbeth.TransactionTrace{transfer(walletB, walletA)}},
+		&pbeth.Block{Number: 12, TransactionTraces: []*pbeth.TransactionTrace{{Receipt: &pbeth.TransactionReceipt{}}}},
+	)
+
+	filter, err := newCombinedFilter(&pbtransform.CombinedFilter{LogFilters: []*pbtransform.LogFilter{
+		{EventSignatures: [][]byte{transferSig}, Topic2: [][]byte{walletB}},
+	}}, nil, nil)
+	require.NoError(t, err)
+	topicOnlyFilter, err := newCombinedFilter(&pbtransform.CombinedFilter{LogFilters: []*pbtransform.LogFilter{
+		{Topic1: [][]byte{walletB}},
+	}}, nil, nil)
+	require.NoError(t, err)
+
+	filterFunc := func(bitmaps testBitmaps) []uint64 {
+		out, supported := filter.indexedBlocks(bitmaps)
+		require.True(t, supported)
+		return out
+	}
+	topicOnlyFilterFunc := func(bitmaps testBitmaps) []uint64 {
+		out, supported := topicOnlyFilter.indexedBlocks(bitmaps)
+		require.True(t, supported)
+		return out
+	}
+
+	assert.Equal(t, []uint64{10}, filterFunc(bitmaps))
+	assert.Equal(t, []uint64{11}, topicOnlyFilterFunc(bitmaps))
+
+	// Index files produced before topics were indexed cannot be narrowed down on topics
+	legacy := bitmaps.without(IdxPrefixLog + "T")
+	assert.Equal(t, []uint64{10, 11}, filterFunc(legacy))
+	assert.Equal(t, []uint64{10, 11}, topicOnlyFilterFunc(legacy))
+}
@@ -0,0 +1,36 @@
+package transform
+
+import (
+	"encoding/binary"
+
+	"github.com/streamingfast/eth-go"
+)
+
+// logsBloomSize is the size in bytes of the logs bloom of a block header (2048 bits)
+const logsBloomSize = 256
+
+// logsBloomMayContain returns false when data (a log address or topic) is certainly not one of the values
+// the logs bloom was computed from, the bits are the ones set by `computeLogsBloom` in the codec package
+func logsBloomMayContain(bloom []byte, data []byte) bool {
+	hash := eth.Keccak256(data)
+	for i := 0; i < 6; i += 2 {
+		bit := binary.BigEndian.Uint16(hash[i:]) & 0x7ff
+		if bloom[logsBloomSize-1-int(bit>>3)]&(1<<(bit&0x7)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// logsBloomMayContainAny returns true if the bloom may contain one of the values, or if values is empty
+func logsBloomMayContainAny[T ~[]byte](bloom []byte, values []T) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, value := range values {
+		if logsBloomMayContain(bloom, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,33 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogsBloomMayContain(t *testing.T) {
+	blk := &pbeth.Block{}
+	require.NoError(t, testBlockFromFiles(t, "block.json").Payload.UnmarshalTo(blk))
+
+	bloom := blk.Header.LogsBloom
+	require.Len(t, bloom, logsBloomSize)
+
+	logs := 0
+	for _, trace := range blk.TransactionTraces {
+		for _, log := range trace.Receipt.Logs {
+			logs++
+			assert.True(t, logsBloomMayContain(bloom, log.Address), "address %x", log.Address)
+			for _, topic := range log.Topics {
+				assert.True(t, logsBloomMayContain(bloom, topic), "topic %x", topic)
+			}
+		}
+	}
+	require.NotZero(t, logs)
+
+	assert.False(t, logsBloomMayContain(bloom, eth.MustNewAddress("0x1111111111111111111111111111111111111111")))
+	assert.False(t, logsBloomMayContain(make([]byte, logsBloomSize), tokenAddr))
+}
@@ -0,0 +1,92 @@
+package transform
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/eth-go"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+)
+
+type SetCodeFilter struct {
+	authorities []eth.Address
+	addresses   []eth.Address
+}
+
+func NewSetCodeFilter(in *pbtransform.SetCodeFilter) (*SetCodeFilter, error) {
+	f := &SetCodeFilter{
+		authorities: make([]eth.Address, len(in.Authorities)),
+		addresses:   make([]eth.Address, len(in.Addresses)),
+	}
+	for i, addr := range in.Authorities {
+		f.authorities[i] = addr
+	}
+	for i, addr := range in.Addresses {
+		f.addresses[i] = addr
+	}
+	return f, nil
+}
+
+func (f *SetCodeFilter) String() string {
+	pretty := func(in []eth.Address) string {
+		out := make([]string, len(in))
+		for i, addr := range in {
+			out[i] = addr.Pretty()
+		}
+		return strings.Join(out, ",")
+	}
+
+	return fmt.Sprintf("{authorities: %s, addresses: %s}", pretty(f.authorities), pretty(f.addresses))
+}
+
+func (f *SetCodeFilter) matches(trace *pbeth.TransactionTrace) bool {
+	for _, authorization := range trace.SetCodeAuthorizations {
+		if authorization.Authority == nil {
+			continue
+		}
+
+		if matchAnyAddress(f.authorities, authorization.Authority) && matchAnyAddress(f.addresses, authorization.Address) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *SetCodeFilter) indexMarker() string {
+	return IdxKeySetCodes
+}
+
+// bitmap finds the blockNums containing set code authorizations matching each of the provided
+// constraints, or all set code authorizations if the filter has no constraint
+func (f *SetCodeFilter) bitmap(bitmaps transform.BitmapGetter) *roaring64.Bitmap {
+	out := roaring64.NewBitmap()
+	if bm := bitmaps.Get(IdxKeyHasSetCode); bm != nil {
+		out.Or(bm)
+	}
+
+	if len(f.authorities) != 0 {
+		out.And(addressBitmap(f.authorities, bitmaps, IdxPrefixSetCodeAuthority))
+	}
+	if len(f.addresses) != 0 {
+		out.And(addressBitmap(f.addresses, bitmaps, IdxPrefixSetCodeAddress))
+	}
+	return out
+}
+
+func setCodeKeys(trace *pbeth.TransactionTrace) map[string]bool {
+	out := make(map[string]bool)
+	for _, authorization := range trace.SetCodeAuthorizations {
+		if authorization.Authority == nil {
+			continue
+		}
+
+		out[IdxKeyHasSetCode] = true
+		out[IdxPrefixSetCodeAuthority+hex.EncodeToString(authorization.Authority)] = true
+		out[IdxPrefixSetCodeAddress+hex.EncodeToString(authorization.Address)] = true
+	}
+	return out
+}
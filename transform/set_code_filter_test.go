@@ -0,0 +1,36 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+)
+
+func TestSetCodeFilter(t *testing.T) {
+	wallet := eth.MustNewAddress("0x1111111111111111111111111111111111111111")
+	otherWallet := eth.MustNewAddress("0x2222222222222222222222222222222222222222")
+
+	setCode := func(authorizations ...*pbeth.SetCodeAuthorization) *pbeth.TransactionTrace {
+		return &pbeth.TransactionTrace{Type: pbeth.TransactionTrace_TRX_TYPE_SET_CODE, Receipt: &pbeth.TransactionReceipt{}, SetCodeAuthorizations: authorizations}
+	}
+
+	blocks := []*pbeth.Block{
+		{Number: 10, TransactionTraces: []*pbeth.TransactionTrace{setCode(&pbeth.SetCodeAuthorization{Address: wallet, Authority: senderA})}},
+		{Number: 11, TransactionTraces: []*pbeth.TransactionTrace{setCode(
+			&pbeth.SetCodeAuthorization{Address: otherWallet, Authority: senderB},
+			&pbeth.SetCodeAuthorization{Address: wallet},
+		)}},
+		{Number: 12, TransactionTraces: []*pbeth.TransactionTrace{{Receipt: &pbeth.TransactionReceipt{}}}},
+	}
+	testFilterKind(t, blocks, IdxKeySetCodes, func(filter *pbtransform.SetCodeFilter) *pbtransform.CombinedFilter {
+		return &pbtransform.CombinedFilter{SetCodeFilters: []*pbtransform.SetCodeFilter{filter}}
+	}, []filterKindTest[*pbtransform.SetCodeFilter]{
+		{"any set code", &pbtransform.SetCodeFilter{}, []uint64{10, 11}, []uint64{10, 11}},
+		{"authority", &pbtransform.SetCodeFilter{Authorities: [][]byte{senderB}}, []uint64{11}, []uint64{11}},
+		{"address", &pbtransform.SetCodeFilter{Addresses: [][]byte{wallet}}, []uint64{10}, []uint64{10}},
+		{"authority and address", &pbtransform.SetCodeFilter{Authorities: [][]byte{senderB}, Addresses: [][]byte{otherWallet}}, []uint64{11}, []uint64{11}},
+		{"no match", &pbtransform.SetCodeFilter{Authorities: [][]byte{senderB}, Addresses: [][]byte{wallet}}, nil, nil},
+	})
+}
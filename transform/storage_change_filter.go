@@ -0,0 +1,81 @@
+package transform
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/eth-go"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+)
+
+type StorageChangeFilter struct {
+	addresses []eth.Address
+	keys      []eth.Hash
+}
+
+func (f *StorageChangeFilter) Addresses() []eth.Address {
+	return f.addresses
+}
+
+// Signatures returns the storage keys of the filter, so it can be used as an AddressSignatureFilter
+func (f *StorageChangeFilter) Signatures() []eth.Hash {
+	return f.keys
+}
+
+func NewStorageChangeFilter(in *pbtransform.StorageChangeFilter) (*StorageChangeFilter, error) {
+	if len(in.Addresses) == 0 && len(in.Keys) == 0 {
+		return nil, fmt.Errorf("a storage change filter transform requires at-least one address or one key")
+	}
+
+	f := &StorageChangeFilter{
+		addresses: make([]eth.Address, len(in.Addresses)),
+		keys:      make([]eth.Hash, len(in.Keys)),
+	}
+	for i, addr := range in.Addresses {
+		f.addresses[i] = addr
+	}
+	for i, key := range in.Keys {
+		if len(key) != 32 {
+			return nil, fmt.Errorf("invalid storage key %x: expected 32 bytes, got %d", key, len(key))
+		}
+		f.keys[i] = key
+	}
+	return f, nil
+}
+
+func (p *StorageChangeFilter) matchStorageChange(change *pbeth.StorageChange) bool {
+	return matchAnyAddress(p.addresses, change.Address) && matchAnyHash(p.keys, change.Key)
+}
+
+func (p *StorageChangeFilter) matches(trace *pbeth.TransactionTrace) bool {
+	for _, call := range trace.Calls {
+		for _, change := range call.StorageChanges {
+			if p.matchStorageChange(change) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (p *StorageChangeFilter) indexMarker() string {
+	return IdxKeyStorageChanges
+}
+
+func (p *StorageChangeFilter) bitmap(bitmaps transform.BitmapGetter) *roaring64.Bitmap {
+	return filterBitmap(p, bitmaps, IdxPrefixStorage)
+}
+
+func storageChangeKeys(trace *pbeth.TransactionTrace, prefix string) map[string]bool {
+	out := make(map[string]bool)
+	for _, call := range trace.Calls {
+		for _, change := range call.StorageChanges {
+			out[prefix+hex.EncodeToString(change.Address)] = true
+			out[prefix+hex.EncodeToString(change.Key)] = true
+		}
+	}
+	return out
+}
@@ -0,0 +1,46 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	implementationSlot = eth.MustNewHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	priceSlot          = eth.MustNewHash("0x0000000000000000000000000000000000000000000000000000000000000003")
+)
+
+func TestStorageChangeFilter(t *testing.T) {
+	storageChange := func(address eth.Address, key eth.Hash) *pbeth.TransactionTrace {
+		return &pbeth.TransactionTrace{
+			Receipt: &pbeth.TransactionReceipt{},
+			Calls: []*pbeth.Call{
+				{Address: address, StorageChanges: []*pbeth.StorageChange{{Address: address, Key: key}}},
+			},
+		}
+	}
+
+	blocks := []*pbeth.Block{
+		{Number: 10, TransactionTraces: []*pbeth.TransactionTrace{storageChange(tokenAddr, implementationSlot)}},
+		{Number: 11, TransactionTraces: []*pbeth.TransactionTrace{storageChange(tokenAddr, priceSlot)}},
+		{Number: 12, TransactionTraces: []*pbeth.TransactionTrace{storageChange(recipient, implementationSlot)}},
+		{Number: 13, TransactionTraces: []*pbeth.TransactionTrace{{Receipt: &pbeth.TransactionReceipt{}}}},
+	}
+	testFilterKind(t, blocks, IdxKeyStorageChanges, func(filter *pbtransform.StorageChangeFilter) *pbtransform.CombinedFilter {
+		return &pbtransform.CombinedFilter{StorageChangeFilters: []*pbtransform.StorageChangeFilter{filter}}
+	}, []filterKindTest[*pbtransform.StorageChangeFilter]{
+		{"address", &pbtransform.StorageChangeFilter{Addresses: [][]byte{tokenAddr}}, []uint64{10, 11}, []uint64{10, 11}},
+		{"key", &pbtransform.StorageChangeFilter{Keys: [][]byte{implementationSlot}}, []uint64{10, 12}, []uint64{10, 12}},
+		{"address and key", &pbtransform.StorageChangeFilter{Addresses: [][]byte{tokenAddr}, Keys: [][]byte{priceSlot}}, []uint64{11}, []uint64{11}},
+		{"no match", &pbtransform.StorageChangeFilter{Addresses: [][]byte{recipient}, Keys: [][]byte{priceSlot}}, nil, nil},
+	})
+}
+
+func TestStorageChangeFilter_InvalidKey(t *testing.T) {
+	_, err := NewStorageChangeFilter(&pbtransform.StorageChangeFilter{Keys: [][]byte{{0x01}}})
+	require.Error(t, err)
+}
@@ -0,0 +1,180 @@
+package transform
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/eth-go"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+)
+
+type TransactionFilter struct {
+	from     []eth.Address
+	to       []eth.Address
+	minValue *big.Int
+	types    []pbeth.TransactionTrace_Type
+	statuses []pbeth.TransactionTraceStatus
+}
+
+func NewTransactionFilter(in *pbtransform.TransactionFilter) (*TransactionFilter, error) {
+	if len(in.From) == 0 && len(in.To) == 0 && len(in.MinValue) == 0 && len(in.Types) == 0 && len(in.Statuses) == 0 {
+		return nil, fmt.Errorf("a transaction filter transform requires at-least one from address, one to address, a min value, one type or one status")
+	}
+
+	f := &TransactionFilter{
+		from:     make([]eth.Address, len(in.From)),
+		to:       make([]eth.Address, len(in.To)),
+		types:    in.Types,
+		statuses: in.Statuses,
+	}
+	for i, addr := range in.From {
+		f.from[i] = addr
+	}
+	for i, addr := range in.To {
+		f.to[i] = addr
+	}
+	if len(in.MinValue) != 0 {
+		f.minValue = new(big.Int).SetBytes(in.MinValue)
+	}
+
+	return f, nil
+}
+
+func (f *TransactionFilter) String() string {
+	from := make([]string, len(f.from))
+	for i, addr := range f.from {
+		from[i] = addr.Pretty()
+	}
+	to := make([]string, len(f.to))
+	for i, addr := range f.to {
+		to[i] = addr.Pretty()
+	}
+
+	minValue := ""
+	if f.minValue != nil {
+		minValue = f.minValue.String()
+	}
+
+	return fmt.Sprintf("{from: %s, to: %s, min_value: %s, types: %v, statuses: %v}", strings.Join(from, ","), strings.Join(to, ","), minValue, f.types, f.statuses)
+}
+
+func (f *TransactionFilter) matchValue(value *pbeth.BigInt) bool {
+	if f.minValue == nil {
+		return true
+	}
+	if value == nil {
+		return f.minValue.Sign() == 0
+	}
+	return value.Native().Cmp(f.minValue) >= 0
+}
+
+func (f *TransactionFilter) matchType(trxType pbeth.TransactionTrace_Type) bool {
+	if len(f.types) == 0 {
+		return true
+	}
+	for _, t := range f.types {
+		if t == trxType {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *TransactionFilter) matchStatus(status pbeth.TransactionTraceStatus) bool {
+	if len(f.statuses) == 0 {
+		return true
+	}
+	for _, s := range f.statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *TransactionFilter) matches(trace *pbeth.TransactionTrace) bool {
+	return matchAnyAddress(f.from, trace.From) &&
+		matchAnyAddress(f.to, trace.To) &&
+		f.matchValue(trace.Value) &&
+		f.matchType(trace.Type) &&
+		f.matchStatus(trace.Status)
+}
+
+func transactionKeys(trace *pbeth.TransactionTrace) map[string]bool {
+	out := map[string]bool{
+		IdxPrefixTrxType + strconv.FormatInt(int64(trace.Type), 10):     true,
+		IdxPrefixTrxStatus + strconv.FormatInt(int64(trace.Status), 10): true,
+	}
+	if len(trace.From) != 0 {
+		out[IdxPrefixTrxFrom+hex.EncodeToString(trace.From)] = true
+	}
+	if len(trace.To) != 0 {
+		out[IdxPrefixTrxTo+hex.EncodeToString(trace.To)] = true
+	}
+	return out
+}
+
+func (f *TransactionFilter) indexMarker() string {
+	return IdxKeyTransactions
+}
+
+// bitmap finds the blockNums matching the provided TransactionFilter, the min value
+// is not indexed so blocks are only narrowed down on the other constraints.
+func (f *TransactionFilter) bitmap(bitmaps transform.BitmapGetter) *roaring64.Bitmap {
+	var out *roaring64.Bitmap
+	and := func(bm *roaring64.Bitmap) {
+		if out == nil {
+			out = bm
+			return
+		}
+		out.And(bm)
+	}
+
+	if len(f.from) != 0 {
+		and(addressBitmap(f.from, bitmaps, IdxPrefixTrxFrom))
+	}
+	if len(f.to) != 0 {
+		and(addressBitmap(f.to, bitmaps, IdxPrefixTrxTo))
+	}
+
+	if len(f.types) != 0 {
+		and(enumBitmap(f.types, bitmaps, IdxPrefixTrxType))
+	}
+	if len(f.statuses) != 0 {
+		and(enumBitmap(f.statuses, bitmaps, IdxPrefixTrxStatus))
+	}
+
+	if out == nil {
+		// Only the min value is set
+		out = transactionsBitmap(bitmaps)
+	}
+
+	return out
+}
+
+// transactionsBitmap finds the blockNums containing at least one transaction, every
+// transaction has a status so this is the union of all the status keys
+func transactionsBitmap(bitmaps transform.BitmapGetter) *roaring64.Bitmap {
+	statuses := make([]pbeth.TransactionTraceStatus, 0, len(pbeth.TransactionTraceStatus_name))
+	for status := range pbeth.TransactionTraceStatus_name {
+		statuses = append(statuses, pbeth.TransactionTraceStatus(status))
+	}
+	return enumBitmap(statuses, bitmaps, IdxPrefixTrxStatus)
+}
+
+// enumBitmap attempts to find the blockNums corresponding to any of the provided enum values
+func enumBitmap[T ~int32](values []T, bitmaps transform.BitmapGetter, idxPrefix string) *roaring64.Bitmap {
+	out := roaring64.NewBitmap()
+	for _, value := range values {
+		if bm := bitmaps.Get(idxPrefix + strconv.FormatInt(int64(value), 10)); bm != nil {
+			out.Or(bm)
+		}
+	}
+	return out
+}
@@ -0,0 +1,81 @@
+package transform
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	senderA   = eth.MustNewAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	senderB   = eth.MustNewAddress("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	recipient = eth.MustNewAddress("0xdddddddddddddddddddddddddddddddddddddddd")
+)
+
+func TestTransactionFilter_Matches(t *testing.T) {
+	trace := &pbeth.TransactionTrace{
+		From:   senderA,
+		To:     recipient,
+		Value:  pbeth.NewBigInt(1000),
+		Type:   pbeth.TransactionTrace_TRX_TYPE_BLOB,
+		Status: pbeth.TransactionTraceStatus_SUCCEEDED,
+	}
+
+	tests := []struct {
+		name   string
+		filter *pbtransform.TransactionFilter
+		expect bool
+	}{
+		{"from", &pbtransform.TransactionFilter{From: [][]byte{senderA}}, true},
+		{"from mismatch", &pbtransform.TransactionFilter{From: [][]byte{senderB}}, false},
+		{"to", &pbtransform.TransactionFilter{To: [][]byte{recipient}}, true},
+		{"from and to mismatch", &pbtransform.TransactionFilter{From: [][]byte{senderA}, To: [][]byte{senderB}}, false},
+		{"min value equal", &pbtransform.TransactionFilter{MinValue: big.NewInt(1000).Bytes()}, true},
+		{"min value above", &pbtransform.TransactionFilter{MinValue: big.NewInt(1001).Bytes()}, false},
+		{"type", &pbtransform.TransactionFilter{Types: []pbeth.TransactionTrace_Type{pbeth.TransactionTrace_TRX_TYPE_LEGACY, pbeth.TransactionTrace_TRX_TYPE_BLOB}}, true},
+		{"type mismatch", &pbtransform.TransactionFilter{Types: []pbeth.TransactionTrace_Type{pbeth.TransactionTrace_TRX_TYPE_DYNAMIC_FEE}}, false},
+		{"status", &pbtransform.TransactionFilter{Statuses: []pbeth.TransactionTraceStatus{pbeth.TransactionTraceStatus_SUCCEEDED}}, true},
+		{"status mismatch", &pbtransform.TransactionFilter{Statuses: []pbeth.TransactionTraceStatus{pbeth.TransactionTraceStatus_FAILED}}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := NewTransactionFilter(test.filter)
+			require.NoError(t, err)
+			assert.Equal(t, test.expect, f.matches(trace))
+		})
+	}
+}
+
+func TestTransactionFilter_Empty(t *testing.T) {
+	_, err := NewTransactionFilter(&pbtransform.TransactionFilter{})
+	require.Error(t, err)
+}
+
+func TestCombinedFilter_TransactionsBitmap(t *testing.T) {
+	trx := func(from eth.Address, trxType pbeth.TransactionTrace_Type, status pbeth.TransactionTraceStatus) *pbeth.TransactionTrace {
+		return &pbeth.TransactionTrace{From: from, To: recipient, Type: trxType, Status: status, Receipt: &pbeth.TransactionReceipt{}}
+	}
+
+	blocks := []*pbeth.Block{
+		{Number: 10, TransactionTraces: []*pbeth.TransactionTrace{trx(senderA, pbeth.TransactionTrace_TRX_TYPE_LEGACY, pbeth.TransactionTraceStatus_SUCCEEDED)}},
+		{Number: 11, TransactionTraces: []*pbeth.TransactionTrace{trx(senderB, pbeth.TransactionTrace_TRX_TYPE_BLOB, pbeth.TransactionTraceStatus_SUCCEEDED)}},
+		{Number: 12, TransactionTraces: []*pbeth.TransactionTrace{trx(senderA, pbeth.TransactionTrace_TRX_TYPE_BLOB, pbeth.TransactionTraceStatus_FAILED)}},
+		{Number: 13},
+	}
+
+	testFilterKind(t, blocks, IdxKeyTransactions, func(filter *pbtransform.TransactionFilter) *pbtransform.CombinedFilter {
+		return &pbtransform.CombinedFilter{TransactionFilters: []*pbtransform.TransactionFilter{filter}}
+	}, []filterKindTest[*pbtransform.TransactionFilter]{
+		{"from", &pbtransform.TransactionFilter{From: [][]byte{senderA}}, []uint64{10, 12}, []uint64{10, 12}},
+		{"type", &pbtransform.TransactionFilter{Types: []pbeth.TransactionTrace_Type{pbeth.TransactionTrace_TRX_TYPE_BLOB}}, []uint64{11, 12}, []uint64{11, 12}},
+		{"from and status", &pbtransform.TransactionFilter{From: [][]byte{senderA}, Statuses: []pbeth.TransactionTraceStatus{pbeth.TransactionTraceStatus_SUCCEEDED}}, []uint64{10}, []uint64{10}},
+		{"min value only", &pbtransform.TransactionFilter{MinValue: big.NewInt(1).Bytes()}, nil, []uint64{10, 11, 12}},
+		{"no match", &pbtransform.TransactionFilter{To: [][]byte{senderA}}, nil, nil},
+	})
+}
@@ -0,0 +1,197 @@
+package transform
+
+import (
+	"fmt"
+	"strings"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var TrimmedFilterMessageName = proto.MessageName(&pbtransform.TrimmedFilter{})
+
+// TrimmableCallFields are the `Call` field paths that can be kept by a TrimmedFilter, all others are
+// always kept.
+var TrimmableCallFields = []string{
+	"storage_changes",
+	"balance_changes",
+	"nonce_changes",
+	"gas_changes",
+	"code_changes",
+	"account_creations",
+	"keccak_preimages",
+	"logs",
+}
+
+func NewTrimmedFilterTransformFactory(indexStore dstore.Store, possibleIndexSizes []uint64) (*transform.Factory, error) {
+	return TrimmedFilterTransformFactory(indexStore, possibleIndexSizes), nil
+}
+
+func TrimmedFilterTransformFactory(indexStore dstore.Store, possibleIndexSizes []uint64) *transform.Factory {
+	return TrimmedFilterTransformFactoryWithLogsBloom(indexStore, possibleIndexSizes, false)
+}
+
+// TrimmedFilterTransformFactoryWithLogsBloom is TrimmedFilterTransformFactory with the block header logs bloom check
+// turned on or off, see CombinedFilterTransformFactoryWithLogsBloom
+func TrimmedFilterTransformFactoryWithLogsBloom(indexStore dstore.Store, possibleIndexSizes []uint64, useLogsBloom bool) *transform.Factory {
+	return &transform.Factory{
+		Obj: &pbtransform.TrimmedFilter{},
+		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
+			mname := message.MessageName()
+			if mname != TrimmedFilterMessageName {
+				return nil, fmt.Errorf("expected type url %q, received %q ", TrimmedFilterMessageName, message.TypeUrl)
+			}
+
+			filter := &pbtransform.TrimmedFilter{}
+			err := proto.Unmarshal(message.Value, filter)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected unmarshall error: %w", err)
+			}
+
+			f, err := newTrimmedFilter(filter, indexStore, possibleIndexSizes)
+			if err != nil {
+				return nil, err
+			}
+			f.useLogsBloom = useLogsBloom
+			return f, nil
+		},
+	}
+}
+
+func newTrimmedFilter(in *pbtransform.TrimmedFilter, indexStore dstore.Store, possibleIndexSizes []uint64) (*TrimmedFilter, error) {
+	if in.Filter == nil {
+		return nil, fmt.Errorf("a trimmed filter transform requires a combined filter")
+	}
+	combined, err := newCombinedFilter(in.Filter, indexStore, possibleIndexSizes)
+	if err != nil {
+		return nil, err
+	}
+	if err := combined.validate(); err != nil {
+		return nil, err
+	}
+
+	keep := make(map[string]bool)
+	for _, path := range in.Keep.GetPaths() {
+		if !isTrimmableCallField(path) {
+			return nil, fmt.Errorf("invalid keep path %q: accepted paths are %s", path, strings.Join(TrimmableCallFields, ", "))
+		}
+		keep[path] = true
+	}
+
+	logFilters := append([]*LogFilter(nil), combined.LogFilters...)
+	if combined.Expression != nil {
+		combined.Expression.visitLeaves(func(leaf traceFilter) {
+			if lf, ok := leaf.(*LogFilter); ok {
+				logFilters = append(logFilters, lf)
+			}
+		})
+	}
+
+	return &TrimmedFilter{
+		CombinedFilter: combined,
+		keep:           keep,
+		logFilters:     logFilters,
+	}, nil
+}
+
+func isTrimmableCallField(path string) bool {
+	for _, field := range TrimmableCallFields {
+		if field == path {
+			return true
+		}
+	}
+	return false
+}
+
+// TrimmedFilter selects transactions like its CombinedFilter then prunes the selected transactions
+// of the call data that was not asked for.
+type TrimmedFilter struct {
+	*CombinedFilter
+
+	keep map[string]bool
+
+	// logFilters are the log filters of the combined filter and of its expression, the logs not matching
+	// any of them are pruned
+	logFilters []*LogFilter
+}
+
+func (f *TrimmedFilter) String() string {
+	var keep []string
+	for _, field := range TrimmableCallFields {
+		if f.keep[field] {
+			keep = append(keep, field)
+		}
+	}
+
+	return fmt.Sprintf("Trimmed filter: Keep:[%s], %s", strings.Join(keep, ","), f.CombinedFilter.String())
+}
+
+func (f *TrimmedFilter) Transform(readOnlyBlk *pbbstream.Block, in transform.Input) (transform.Output, error) {
+	out, err := f.CombinedFilter.Transform(readOnlyBlk, in)
+	if err != nil {
+		return nil, err
+	}
+
+	ethBlock := out.(*pbeth.Block)
+	for _, trace := range ethBlock.TransactionTraces {
+		f.trim(trace)
+	}
+
+	return ethBlock, nil
+}
+
+func (f *TrimmedFilter) trim(trace *pbeth.TransactionTrace) {
+	for _, call := range trace.Calls {
+		if !f.keep["storage_changes"] {
+			call.StorageChanges = nil
+		}
+		if !f.keep["balance_changes"] {
+			call.BalanceChanges = nil
+		}
+		if !f.keep["nonce_changes"] {
+			call.NonceChanges = nil
+		}
+		if !f.keep["gas_changes"] {
+			call.GasChanges = nil
+		}
+		if !f.keep["code_changes"] {
+			call.CodeChanges = nil
+		}
+		if !f.keep["account_creations"] {
+			call.AccountCreations = nil
+		}
+		if !f.keep["keccak_preimages"] {
+			call.KeccakPreimages = nil
+		}
+		if !f.keep["logs"] {
+			if f.excludeRevertedCalls && call.StateReverted {
+				call.Logs = nil
+			} else {
+				call.Logs = f.matchingLogs(call.Logs)
+			}
+		}
+	}
+
+	if !f.keep["logs"] && trace.Receipt != nil {
+		trace.Receipt.Logs = f.matchingLogs(trace.Receipt.Logs)
+	}
+}
+
+// matchingLogs returns the logs matching at least one of the log filters, including the ones of the
+// expression, the returned logs are the same instances so their ordinal and indexes are preserved
+func (f *TrimmedFilter) matchingLogs(logs []*pbeth.Log) (out []*pbeth.Log) {
+	for _, log := range logs {
+		for _, lf := range f.logFilters {
+			if lf.matchLog(log) {
+				out = append(out, log)
+				break
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,115 @@
+package transform
+
+import (
+	"testing"
+
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestTrimmedFilter_Transform(t *testing.T) {
+	matchingLog := &pbeth.Log{Address: tokenAddr, Topics: [][]byte{transferSig}, Index: 1, Ordinal: 12}
+	otherLog := &pbeth.Log{Address: recipient, Topics: [][]byte{transferSig}, Index: 0, Ordinal: 11}
+
+	block := &pbeth.Block{
+		Number: 10,
+		TransactionTraces: []*pbeth.TransactionTrace{
+			{
+				Receipt: &pbeth.TransactionReceipt{Logs: []*pbeth.Log{otherLog, matchingLog}},
+				Calls: []*pbeth.Call{
+					{
+						Index:          1,
+						StorageChanges: []*pbeth.StorageChange{{Address: tokenAddr, Ordinal: 5}},
+						BalanceChanges: []*pbeth.BalanceChange{{Address: tokenAddr, Ordinal: 6}},
+						NonceChanges:   []*pbeth.NonceChange{{Address: tokenAddr, Ordinal: 7}},
+						GasChanges:     []*pbeth.GasChange{{Ordinal: 8}},
+						Logs:           []*pbeth.Log{otherLog, matchingLog},
+					},
+				},
+			},
+			{
+				Receipt: &pbeth.TransactionReceipt{Logs: []*pbeth.Log{otherLog}},
+			},
+		},
+	}
+
+	payload, err := anypb.New(block)
+	require.NoError(t, err)
+
+	filter, err := newTrimmedFilter(&pbtransform.TrimmedFilter{
+		Filter: &pbtransform.CombinedFilter{LogFilters: []*pbtransform.LogFilter{{Addresses: [][]byte{tokenAddr}}}},
+		Keep:   &fieldmaskpb.FieldMask{Paths: []string{"balance_changes"}},
+	}, nil, nil)
+	require.NoError(t, err)
+
+	output, err := filter.Transform(&pbbstream.Block{Number: 10, Payload: payload}, nil)
+	require.NoError(t, err)
+
+	trimmed := output.(*pbeth.Block)
+	require.Len(t, trimmed.TransactionTraces, 1)
+
+	trace := trimmed.TransactionTraces[0]
+	assertProtoEqual(t, &pbeth.TransactionReceipt{Logs: []*pbeth.Log{matchingLog}}, trace.Receipt)
+	assertProtoEqual(t, &pbeth.Call{
+		Index:          1,
+		BalanceChanges: []*pbeth.BalanceChange{{Address: tokenAddr, Ordinal: 6}},
+		Logs:           []*pbeth.Log{matchingLog},
+	}, trace.Calls[0])
+}
+
+func TestTrimmedFilter_ExpressionLogFilters(t *testing.T) {
+	matchingLog := &pbeth.Log{Address: tokenAddr, Topics: [][]byte{transferSig}, Index: 1, Ordinal: 12}
+	otherLog := &pbeth.Log{Address: recipient, Topics: [][]byte{transferSig}, Index: 0, Ordinal: 11}
+
+	block := &pbeth.Block{
+		Number: 10,
+		TransactionTraces: []*pbeth.TransactionTrace{
+			{
+				From:    senderA,
+				Receipt: &pbeth.TransactionReceipt{Logs: []*pbeth.Log{otherLog, matchingLog}},
+				Calls:   []*pbeth.Call{{Index: 1, Logs: []*pbeth.Log{otherLog, matchingLog}}},
+			},
+			{
+				From:    senderB,
+				Receipt: &pbeth.TransactionReceipt{Logs: []*pbeth.Log{matchingLog}},
+			},
+		},
+	}
+
+	payload, err := anypb.New(block)
+	require.NoError(t, err)
+
+	filter, err := newTrimmedFilter(&pbtransform.TrimmedFilter{
+		Filter: &pbtransform.CombinedFilter{Expression: &pbtransform.FilterExpression{Expression: &pbtransform.FilterExpression_And{And: &pbtransform.FilterExpressions{Expressions: []*pbtransform.FilterExpression{
+			{Expression: &pbtransform.FilterExpression_LogFilter{LogFilter: &pbtransform.LogFilter{Addresses: [][]byte{tokenAddr}}}},
+			{Expression: &pbtransform.FilterExpression_TransactionFilter{TransactionFilter: &pbtransform.TransactionFilter{From: [][]byte{senderA}}}},
+		}}}}},
+	}, nil, nil)
+	require.NoError(t, err)
+
+	output, err := filter.Transform(&pbbstream.Block{Number: 10, Payload: payload}, nil)
+	require.NoError(t, err)
+
+	trimmed := output.(*pbeth.Block)
+	require.Len(t, trimmed.TransactionTraces, 1)
+
+	trace := trimmed.TransactionTraces[0]
+	assertProtoEqual(t, &pbeth.TransactionReceipt{Logs: []*pbeth.Log{matchingLog}}, trace.Receipt)
+	assertProtoEqual(t, &pbeth.Call{Index: 1, Logs: []*pbeth.Log{matchingLog}}, trace.Calls[0])
+}
+
+func TestTrimmedFilter_InvalidKeep(t *testing.T) {
+	_, err := newTrimmedFilter(&pbtransform.TrimmedFilter{
+		Filter: &pbtransform.CombinedFilter{SendAllBlockHeaders: true},
+		Keep:   &fieldmaskpb.FieldMask{Paths: []string{"input"}},
+	}, nil, nil)
+	assert.Error(t, err)
+
+	_, err = newTrimmedFilter(&pbtransform.TrimmedFilter{}, nil, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,111 @@
+package transform
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/eth-go"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+)
+
+type ValueTransferFilter struct {
+	from     []eth.Address
+	to       []eth.Address
+	minValue *big.Int
+}
+
+func NewValueTransferFilter(in *pbtransform.ValueTransferFilter) (*ValueTransferFilter, error) {
+	f := &ValueTransferFilter{
+		from: make([]eth.Address, len(in.From)),
+		to:   make([]eth.Address, len(in.To)),
+	}
+	for i, addr := range in.From {
+		f.from[i] = addr
+	}
+	for i, addr := range in.To {
+		f.to[i] = addr
+	}
+	if len(in.MinValue) != 0 {
+		f.minValue = new(big.Int).SetBytes(in.MinValue)
+	}
+	return f, nil
+}
+
+func (f *ValueTransferFilter) String() string {
+	pretty := func(in []eth.Address) string {
+		out := make([]string, len(in))
+		for i, addr := range in {
+			out[i] = addr.Pretty()
+		}
+		return strings.Join(out, ",")
+	}
+
+	minValue := ""
+	if f.minValue != nil {
+		minValue = f.minValue.String()
+	}
+
+	return fmt.Sprintf("{from: %s, to: %s, min_value: %s}", pretty(f.from), pretty(f.to), minValue)
+}
+
+// transfersValue returns true when the call moves native value from its caller to its address, the value of
+// `DELEGATE` and `CALLCODE` calls is the one of their parent call and is not transferred
+func transfersValue(call *pbeth.Call) bool {
+	if call.StateReverted || (call.CallType != pbeth.CallType_CALL && call.CallType != pbeth.CallType_CREATE) {
+		return false
+	}
+	return call.Value != nil && call.Value.Native().Sign() > 0
+}
+
+func (f *ValueTransferFilter) matches(trace *pbeth.TransactionTrace) bool {
+	for _, call := range trace.Calls {
+		if !transfersValue(call) {
+			continue
+		}
+
+		if matchAnyAddress(f.from, call.Caller) && matchAnyAddress(f.to, call.Address) && (f.minValue == nil || call.Value.Native().Cmp(f.minValue) >= 0) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *ValueTransferFilter) indexMarker() string {
+	return IdxKeyValueTransfers
+}
+
+// bitmap finds the blockNums containing value transfers from one of the senders (if any) and to one of
+// the recipients (if any), the min value is not indexed so blocks are only narrowed down on the addresses
+func (f *ValueTransferFilter) bitmap(bitmaps transform.BitmapGetter) *roaring64.Bitmap {
+	out := roaring64.NewBitmap()
+	if bm := bitmaps.Get(IdxKeyHasValueTransfer); bm != nil {
+		out.Or(bm)
+	}
+
+	if len(f.from) != 0 {
+		out.And(addressBitmap(f.from, bitmaps, IdxPrefixValueFrom))
+	}
+	if len(f.to) != 0 {
+		out.And(addressBitmap(f.to, bitmaps, IdxPrefixValueTo))
+	}
+	return out
+}
+
+func valueTransferKeys(trace *pbeth.TransactionTrace) map[string]bool {
+	out := make(map[string]bool)
+	for _, call := range trace.Calls {
+		if !transfersValue(call) {
+			continue
+		}
+
+		out[IdxKeyHasValueTransfer] = true
+		out[IdxPrefixValueFrom+hex.EncodeToString(call.Caller)] = true
+		out[IdxPrefixValueTo+hex.EncodeToString(call.Address)] = true
+	}
+	return out
+}
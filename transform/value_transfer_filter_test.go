@@ -0,0 +1,52 @@
+package transform
+
+import (
+	"testing"
+
+	"github.com/streamingfast/eth-go"
+	pbtransform "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/transform/v1"
+	pbeth "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
+)
+
+func TestValueTransferFilter(t *testing.T) {
+	vault := eth.MustNewAddress("0x1111111111111111111111111111111111111111")
+
+	transfer := func(callType pbeth.CallType, caller, address eth.Address, value int64, reverted bool) *pbeth.Call {
+		return &pbeth.Call{CallType: callType, Caller: caller, Address: address, Value: pbeth.NewBigInt(value), StateReverted: reverted}
+	}
+	calls := func(calls ...*pbeth.Call) *pbeth.TransactionTrace {
+		return &pbeth.TransactionTrace{Receipt: &pbeth.TransactionReceipt{}, Calls: calls}
+	}
+
+	blocks := []*pbeth.Block{
+		// top-level transfer
+		{Number: 10, TransactionTraces: []*pbeth.TransactionTrace{calls(
+			transfer(pbeth.CallType_CALL, senderA, recipient, 100, false),
+		)}},
+		// internal transfer from a contract
+		{Number: 11, TransactionTraces: []*pbeth.TransactionTrace{calls(
+			transfer(pbeth.CallType_CALL, senderB, vault, 0, false),
+			transfer(pbeth.CallType_CALL, vault, recipient, 5, false),
+		)}},
+		// contract creation endowed with value
+		{Number: 12, TransactionTraces: []*pbeth.TransactionTrace{calls(
+			transfer(pbeth.CallType_CREATE, senderA, tokenAddr, 1000, false),
+		)}},
+		// reverted transfer and delegate call carrying the value of its parent
+		{Number: 13, TransactionTraces: []*pbeth.TransactionTrace{calls(
+			transfer(pbeth.CallType_CALL, senderB, vault, 0, false),
+			transfer(pbeth.CallType_CALL, vault, recipient, 5, true),
+			transfer(pbeth.CallType_DELEGATE, vault, tokenAddr, 5, false),
+		)}},
+	}
+	testFilterKind(t, blocks, IdxKeyValueTransfers, func(filter *pbtransform.ValueTransferFilter) *pbtransform.CombinedFilter {
+		return &pbtransform.CombinedFilter{ValueTransferFilters: []*pbtransform.ValueTransferFilter{filter}}
+	}, []filterKindTest[*pbtransform.ValueTransferFilter]{
+		{"any transfer", &pbtransform.ValueTransferFilter{}, []uint64{10, 11, 12}, []uint64{10, 11, 12}},
+		{"from", &pbtransform.ValueTransferFilter{From: [][]byte{senderA}}, []uint64{10, 12}, []uint64{10, 12}},
+		{"to", &pbtransform.ValueTransferFilter{To: [][]byte{recipient}}, []uint64{10, 11}, []uint64{10, 11}},
+		{"from and to", &pbtransform.ValueTransferFilter{From: [][]byte{vault}, To: [][]byte{recipient}}, []uint64{11}, []uint64{11}},
+		{"min value", &pbtransform.ValueTransferFilter{MinValue: pbeth.NewBigInt(100).Bytes}, []uint64{10, 12}, []uint64{10, 11, 12}},
+		{"delegate call", &pbtransform.ValueTransferFilter{To: [][]byte{tokenAddr}, From: [][]byte{vault}}, nil, nil},
+	})
+}
@@ -7,8 +7,10 @@
 package pbtransform
 
 import (
+	v2 "github.com/streamingfast/firehose-ethereum/types/pb/sf/ethereum/type/v2"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,7 +23,8 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// CombinedFilter is a combination of "LogFilters" and "CallToFilters"
+// CombinedFilter is a combination of "LogFilters", "CallToFilters", "TransactionFilters", "StorageChangeFilters",
+// "BalanceChangeFilters" and "ContractCreationFilters"
 //
 // It transforms the requested stream in two ways:
 //
@@ -45,9 +48,24 @@ type CombinedFilter struct {
 	CallFilters []*CallToFilter        `protobuf:"bytes,2,rep,name=call_filters,json=callFilters,proto3" json:"call_filters,omitempty"`
 	// Always send all blocks. if they don't match any log_filters or call_filters,
 	// all the transactions will be filtered out, sending only the header.
-	SendAllBlockHeaders bool `protobuf:"varint,3,opt,name=send_all_block_headers,json=sendAllBlockHeaders,proto3" json:"send_all_block_headers,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	SendAllBlockHeaders     bool                      `protobuf:"varint,3,opt,name=send_all_block_headers,json=sendAllBlockHeaders,proto3" json:"send_all_block_headers,omitempty"`
+	TransactionFilters      []*TransactionFilter      `protobuf:"bytes,4,rep,name=transaction_filters,json=transactionFilters,proto3" json:"transaction_filters,omitempty"`
+	StorageChangeFilters    []*StorageChangeFilter    `protobuf:"bytes,5,rep,name=storage_change_filters,json=storageChangeFilters,proto3" json:"storage_change_filters,omitempty"`
+	BalanceChangeFilters    []*BalanceChangeFilter    `protobuf:"bytes,6,rep,name=balance_change_filters,json=balanceChangeFilters,proto3" json:"balance_change_filters,omitempty"`
+	ContractCreationFilters []*ContractCreationFilter `protobuf:"bytes,7,rep,name=contract_creation_filters,json=contractCreationFilters,proto3" json:"contract_creation_filters,omitempty"`
+	// A transaction matching the expression is kept, in addition to the ones matching any of the filters above.
+	Expression *FilterExpression `protobuf:"bytes,8,opt,name=expression,proto3" json:"expression,omitempty"`
+	// When set, transactions whose status is `FAILED` or `REVERTED` are never matched.
+	ExcludeFailedTransactions bool `protobuf:"varint,9,opt,name=exclude_failed_transactions,json=excludeFailedTransactions,proto3" json:"exclude_failed_transactions,omitempty"`
+	// When set, calls that have been reverted (`state_reverted == true`) and logs of failed or reverted
+	// transactions are ignored when evaluating call filters and log filters (including the ones used in
+	// the expression), so only effective calls and logs can match.
+	ExcludeRevertedCalls bool                   `protobuf:"varint,10,opt,name=exclude_reverted_calls,json=excludeRevertedCalls,proto3" json:"exclude_reverted_calls,omitempty"`
+	ValueTransferFilters []*ValueTransferFilter `protobuf:"bytes,11,rep,name=value_transfer_filters,json=valueTransferFilters,proto3" json:"value_transfer_filters,omitempty"`
+	BlobFilters          []*BlobFilter          `protobuf:"bytes,12,rep,name=blob_filters,json=blobFilters,proto3" json:"blob_filters,omitempty"`
+	SetCodeFilters       []*SetCodeFilter       `protobuf:"bytes,13,rep,name=set_code_filters,json=setCodeFilters,proto3" json:"set_code_filters,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *CombinedFilter) Reset() {
@@ -101,6 +119,803 @@ func (x *CombinedFilter) GetSendAllBlockHeaders() bool {
 	return false
 }
 
+func (x *CombinedFilter) GetTransactionFilters() []*TransactionFilter {
+	if x != nil {
+		return x.TransactionFilters
+	}
+	return nil
+}
+
+func (x *CombinedFilter) GetStorageChangeFilters() []*StorageChangeFilter {
+	if x != nil {
+		return x.StorageChangeFilters
+	}
+	return nil
+}
+
+func (x *CombinedFilter) GetBalanceChangeFilters() []*BalanceChangeFilter {
+	if x != nil {
+		return x.BalanceChangeFilters
+	}
+	return nil
+}
+
+func (x *CombinedFilter) GetContractCreationFilters() []*ContractCreationFilter {
+	if x != nil {
+		return x.ContractCreationFilters
+	}
+	return nil
+}
+
+func (x *CombinedFilter) GetExpression() *FilterExpression {
+	if x != nil {
+		return x.Expression
+	}
+	return nil
+}
+
+func (x *CombinedFilter) GetExcludeFailedTransactions() bool {
+	if x != nil {
+		return x.ExcludeFailedTransactions
+	}
+	return false
+}
+
+func (x *CombinedFilter) GetExcludeRevertedCalls() bool {
+	if x != nil {
+		return x.ExcludeRevertedCalls
+	}
+	return false
+}
+
+func (x *CombinedFilter) GetValueTransferFilters() []*ValueTransferFilter {
+	if x != nil {
+		return x.ValueTransferFilters
+	}
+	return nil
+}
+
+func (x *CombinedFilter) GetBlobFilters() []*BlobFilter {
+	if x != nil {
+		return x.BlobFilters
+	}
+	return nil
+}
+
+func (x *CombinedFilter) GetSetCodeFilters() []*SetCodeFilter {
+	if x != nil {
+		return x.SetCodeFilters
+	}
+	return nil
+}
+
+// FilterExpression is a boolean composition of filters, evaluated against each transaction. A transaction
+// matches an `and` expression if it matches all of its expressions, an `or` expression if it matches any of
+// its expressions and a `not` expression if it does not match its expression. A leaf filter is matched as when
+// used directly in CombinedFilter.
+//
+// When using the block index, a `not` expression can only be resolved to the blocks containing at least one
+// transaction, so a top-level `not` expression won't skip much, combine it with an `and` to narrow it down.
+type FilterExpression struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Expression:
+	//
+	//	*FilterExpression_And
+	//	*FilterExpression_Or
+	//	*FilterExpression_Not
+	//	*FilterExpression_LogFilter
+	//	*FilterExpression_CallFilter
+	//	*FilterExpression_TransactionFilter
+	//	*FilterExpression_StorageChangeFilter
+	//	*FilterExpression_BalanceChangeFilter
+	//	*FilterExpression_ContractCreationFilter
+	//	*FilterExpression_ValueTransferFilter
+	//	*FilterExpression_BlobFilter
+	//	*FilterExpression_SetCodeFilter
+	Expression    isFilterExpression_Expression `protobuf_oneof:"expression"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterExpression) Reset() {
+	*x = FilterExpression{}
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterExpression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterExpression) ProtoMessage() {}
+
+func (x *FilterExpression) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterExpression.ProtoReflect.Descriptor instead.
+func (*FilterExpression) Descriptor() ([]byte, []int) {
+	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FilterExpression) GetExpression() isFilterExpression_Expression {
+	if x != nil {
+		return x.Expression
+	}
+	return nil
+}
+
+func (x *FilterExpression) GetAnd() *FilterExpressions {
+	if x != nil {
+		if x, ok := x.Expression.(*FilterExpression_And); ok {
+			return x.And
+		}
+	}
+	return nil
+}
+
+func (x *FilterExpression) GetOr() *FilterExpressions {
+	if x != nil {
+		if x, ok := x.Expression.(*FilterExpression_Or); ok {
+			return x.Or
+		}
+	}
+	return nil
+}
+
+func (x *FilterExpression) GetNot() *FilterExpression {
+	if x != nil {
+		if x, ok := x.Expression.(*FilterExpression_Not); ok {
+			return x.Not
+		}
+	}
+	return nil
+}
+
+func (x *FilterExpression) GetLogFilter() *LogFilter {
+	if x != nil {
+		if x, ok := x.Expression.(*FilterExpression_LogFilter); ok {
+			return x.LogFilter
+		}
+	}
+	return nil
+}
+
+func (x *FilterExpression) GetCallFilter() *CallToFilter {
+	if x != nil {
+		if x, ok := x.Expression.(*FilterExpression_CallFilter); ok {
+			return x.CallFilter
+		}
+	}
+	return nil
+}
+
+func (x *FilterExpression) GetTransactionFilter() *TransactionFilter {
+	if x != nil {
+		if x, ok := x.Expression.(*FilterExpression_TransactionFilter); ok {
+			return x.TransactionFilter
+		}
+	}
+	return nil
+}
+
+func (x *FilterExpression) GetStorageChangeFilter() *StorageChangeFilter {
+	if x != nil {
+		if x, ok := x.Expression.(*FilterExpression_StorageChangeFilter); ok {
+			return x.StorageChangeFilter
+		}
+	}
+	return nil
+}
+
+func (x *FilterExpression) GetBalanceChangeFilter() *BalanceChangeFilter {
+	if x != nil {
+		if x, ok := x.Expression.(*FilterExpression_BalanceChangeFilter); ok {
+			return x.BalanceChangeFilter
+		}
+	}
+	return nil
+}
+
+func (x *FilterExpression) GetContractCreationFilter() *ContractCreationFilter {
+	if x != nil {
+		if x, ok := x.Expression.(*FilterExpression_ContractCreationFilter); ok {
+			return x.ContractCreationFilter
+		}
+	}
+	return nil
+}
+
+func (x *FilterExpression) GetValueTransferFilter() *ValueTransferFilter {
+	if x != nil {
+		if x, ok := x.Expression.(*FilterExpression_ValueTransferFilter); ok {
+			return x.ValueTransferFilter
+		}
+	}
+	return nil
+}
+
+func (x *FilterExpression) GetBlobFilter() *BlobFilter {
+	if x != nil {
+		if x, ok := x.Expression.(*FilterExpression_BlobFilter); ok {
+			return x.BlobFilter
+		}
+	}
+	return nil
+}
+
+func (x *FilterExpression) GetSetCodeFilter() *SetCodeFilter {
+	if x != nil {
+		if x, ok := x.Expression.(*FilterExpression_SetCodeFilter); ok {
+			return x.SetCodeFilter
+		}
+	}
+	return nil
+}
+
+type isFilterExpression_Expression interface {
+	isFilterExpression_Expression()
+}
+
+type FilterExpression_And struct {
+	And *FilterExpressions `protobuf:"bytes,1,opt,name=and,proto3,oneof"`
+}
+
+type FilterExpression_Or struct {
+	Or *FilterExpressions `protobuf:"bytes,2,opt,name=or,proto3,oneof"`
+}
+
+type FilterExpression_Not struct {
+	Not *FilterExpression `protobuf:"bytes,3,opt,name=not,proto3,oneof"`
+}
+
+type FilterExpression_LogFilter struct {
+	LogFilter *LogFilter `protobuf:"bytes,10,opt,name=log_filter,json=logFilter,proto3,oneof"`
+}
+
+type FilterExpression_CallFilter struct {
+	CallFilter *CallToFilter `protobuf:"bytes,11,opt,name=call_filter,json=callFilter,proto3,oneof"`
+}
+
+type FilterExpression_TransactionFilter struct {
+	TransactionFilter *TransactionFilter `protobuf:"bytes,12,opt,name=transaction_filter,json=transactionFilter,proto3,oneof"`
+}
+
+type FilterExpression_StorageChangeFilter struct {
+	StorageChangeFilter *StorageChangeFilter `protobuf:"bytes,13,opt,name=storage_change_filter,json=storageChangeFilter,proto3,oneof"`
+}
+
+type FilterExpression_BalanceChangeFilter struct {
+	BalanceChangeFilter *BalanceChangeFilter `protobuf:"bytes,14,opt,name=balance_change_filter,json=balanceChangeFilter,proto3,oneof"`
+}
+
+type FilterExpression_ContractCreationFilter struct {
+	ContractCreationFilter *ContractCreationFilter `protobuf:"bytes,15,opt,name=contract_creation_filter,json=contractCreationFilter,proto3,oneof"`
+}
+
+type FilterExpression_ValueTransferFilter struct {
+	ValueTransferFilter *ValueTransferFilter `protobuf:"bytes,16,opt,name=value_transfer_filter,json=valueTransferFilter,proto3,oneof"`
+}
+
+type FilterExpression_BlobFilter struct {
+	BlobFilter *BlobFilter `protobuf:"bytes,17,opt,name=blob_filter,json=blobFilter,proto3,oneof"`
+}
+
+type FilterExpression_SetCodeFilter struct {
+	SetCodeFilter *SetCodeFilter `protobuf:"bytes,18,opt,name=set_code_filter,json=setCodeFilter,proto3,oneof"`
+}
+
+func (*FilterExpression_And) isFilterExpression_Expression() {}
+
+func (*FilterExpression_Or) isFilterExpression_Expression() {}
+
+func (*FilterExpression_Not) isFilterExpression_Expression() {}
+
+func (*FilterExpression_LogFilter) isFilterExpression_Expression() {}
+
+func (*FilterExpression_CallFilter) isFilterExpression_Expression() {}
+
+func (*FilterExpression_TransactionFilter) isFilterExpression_Expression() {}
+
+func (*FilterExpression_StorageChangeFilter) isFilterExpression_Expression() {}
+
+func (*FilterExpression_BalanceChangeFilter) isFilterExpression_Expression() {}
+
+func (*FilterExpression_ContractCreationFilter) isFilterExpression_Expression() {}
+
+func (*FilterExpression_ValueTransferFilter) isFilterExpression_Expression() {}
+
+func (*FilterExpression_BlobFilter) isFilterExpression_Expression() {}
+
+func (*FilterExpression_SetCodeFilter) isFilterExpression_Expression() {}
+
+type FilterExpressions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expressions   []*FilterExpression    `protobuf:"bytes,1,rep,name=expressions,proto3" json:"expressions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterExpressions) Reset() {
+	*x = FilterExpressions{}
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterExpressions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterExpressions) ProtoMessage() {}
+
+func (x *FilterExpressions) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterExpressions.ProtoReflect.Descriptor instead.
+func (*FilterExpressions) Descriptor() ([]byte, []int) {
+	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FilterExpressions) GetExpressions() []*FilterExpression {
+	if x != nil {
+		return x.Expressions
+	}
+	return nil
+}
+
+// StorageChangeFilter will match transactions containing a storage change where *BOTH*
+// * the contract address owning the storage is one in the provided addresses -- OR addresses list is empty --
+// * the storage key (slot) is one of the provided keys -- OR keys list is empty --
+//
+// a StorageChangeFilter with both empty addresses and keys lists is invalid and will fail.
+type StorageChangeFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addresses     [][]byte               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Keys          [][]byte               `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"` // 32 bytes storage slots
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorageChangeFilter) Reset() {
+	*x = StorageChangeFilter{}
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageChangeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageChangeFilter) ProtoMessage() {}
+
+func (x *StorageChangeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageChangeFilter.ProtoReflect.Descriptor instead.
+func (*StorageChangeFilter) Descriptor() ([]byte, []int) {
+	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StorageChangeFilter) GetAddresses() [][]byte {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *StorageChangeFilter) GetKeys() [][]byte {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// BalanceChangeFilter will match balance changes where *BOTH*
+// * the account is one in the provided addresses -- OR addresses list is empty --
+// * the reason is one of the provided reasons -- OR reasons list is empty --
+//
+// Transactions are selected when one of their calls has a matching balance change. Block level
+// balance changes (block rewards, withdrawals, etc.) are always sent along the block, so when one
+// of them matches, the block is kept even if none of its transactions match.
+//
+// a BalanceChangeFilter with both empty addresses and reasons lists is invalid and will fail.
+type BalanceChangeFilter struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Addresses     [][]byte                  `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Reasons       []v2.BalanceChange_Reason `protobuf:"varint,2,rep,packed,name=reasons,proto3,enum=sf.ethereum.type.v2.BalanceChange_Reason" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceChangeFilter) Reset() {
+	*x = BalanceChangeFilter{}
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceChangeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceChangeFilter) ProtoMessage() {}
+
+func (x *BalanceChangeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceChangeFilter.ProtoReflect.Descriptor instead.
+func (*BalanceChangeFilter) Descriptor() ([]byte, []int) {
+	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BalanceChangeFilter) GetAddresses() [][]byte {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *BalanceChangeFilter) GetReasons() []v2.BalanceChange_Reason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+// ContractCreationFilter will match transactions deploying a contract (a call of type `CREATE`) where *ALL* of
+// * the transaction sender (FROM) is one in the provided deployers -- OR deployers list is empty --
+// * the creating call's caller is one in the provided factories -- OR factories list is empty --
+// * the hash of the deployed code is one in the provided code_hashes -- OR code_hashes list is empty --
+//
+// a ContractCreationFilter with all lists empty matches all transactions deploying a contract. Top-level
+// deployments have the transaction sender as caller, contracts deployed by a factory contract have the
+// factory as caller.
+type ContractCreationFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deployers     [][]byte               `protobuf:"bytes,1,rep,name=deployers,proto3" json:"deployers,omitempty"`
+	Factories     [][]byte               `protobuf:"bytes,2,rep,name=factories,proto3" json:"factories,omitempty"`
+	CodeHashes    [][]byte               `protobuf:"bytes,3,rep,name=code_hashes,json=codeHashes,proto3" json:"code_hashes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContractCreationFilter) Reset() {
+	*x = ContractCreationFilter{}
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContractCreationFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractCreationFilter) ProtoMessage() {}
+
+func (x *ContractCreationFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractCreationFilter.ProtoReflect.Descriptor instead.
+func (*ContractCreationFilter) Descriptor() ([]byte, []int) {
+	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ContractCreationFilter) GetDeployers() [][]byte {
+	if x != nil {
+		return x.Deployers
+	}
+	return nil
+}
+
+func (x *ContractCreationFilter) GetFactories() [][]byte {
+	if x != nil {
+		return x.Factories
+	}
+	return nil
+}
+
+func (x *ContractCreationFilter) GetCodeHashes() [][]byte {
+	if x != nil {
+		return x.CodeHashes
+	}
+	return nil
+}
+
+// ValueTransferFilter will match transactions containing a call transferring native value (a non-reverted
+// `CALL` or `CREATE` with a non-zero value, top-level or internal) where *ALL* of
+// * the sender (the call's caller) is one in the provided from addresses -- OR from list is empty --
+// * the recipient (the call's address) is one in the provided to addresses -- OR to list is empty --
+// * the transferred value is greater or equal to min_value -- OR min_value is empty --
+//
+// a ValueTransferFilter with all fields empty matches all transactions transferring native value.
+// `DELEGATE` and `CALLCODE` calls carry the value of their parent call without transferring it, so
+// they are never matched.
+type ValueTransferFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  [][]byte               `protobuf:"bytes,1,rep,name=from,proto3" json:"from,omitempty"`
+	To    [][]byte               `protobuf:"bytes,2,rep,name=to,proto3" json:"to,omitempty"`
+	// Minimum value in wei that the call must transfer, as a big-endian unsigned integer, the
+	// value is not part of the block index so it is only applied when filtering the transactions.
+	MinValue      []byte `protobuf:"bytes,3,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValueTransferFilter) Reset() {
+	*x = ValueTransferFilter{}
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValueTransferFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValueTransferFilter) ProtoMessage() {}
+
+func (x *ValueTransferFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValueTransferFilter.ProtoReflect.Descriptor instead.
+func (*ValueTransferFilter) Descriptor() ([]byte, []int) {
+	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ValueTransferFilter) GetFrom() [][]byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ValueTransferFilter) GetTo() [][]byte {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ValueTransferFilter) GetMinValue() []byte {
+	if x != nil {
+		return x.MinValue
+	}
+	return nil
+}
+
+// BlobFilter will match blob transactions (EIP-4844, carrying at least one blob hash) where *ALL* of
+// * the transaction sender (FROM) is one in the provided from addresses -- OR from list is empty --
+// * one of the transaction's blob versioned hashes is one in the provided blob_hashes -- OR blob_hashes list is empty --
+//
+// a BlobFilter with both lists empty matches all blob transactions.
+type BlobFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          [][]byte               `protobuf:"bytes,1,rep,name=from,proto3" json:"from,omitempty"`
+	BlobHashes    [][]byte               `protobuf:"bytes,2,rep,name=blob_hashes,json=blobHashes,proto3" json:"blob_hashes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlobFilter) Reset() {
+	*x = BlobFilter{}
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlobFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobFilter) ProtoMessage() {}
+
+func (x *BlobFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobFilter.ProtoReflect.Descriptor instead.
+func (*BlobFilter) Descriptor() ([]byte, []int) {
+	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BlobFilter) GetFrom() [][]byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *BlobFilter) GetBlobHashes() [][]byte {
+	if x != nil {
+		return x.BlobHashes
+	}
+	return nil
+}
+
+// SetCodeFilter will match set code transactions (EIP-7702) containing an authorization where *ALL* of
+// * the authority (the account delegating its code) is one in the provided authorities -- OR authorities list is empty --
+// * the delegated address (the contract whose code is used) is one in the provided addresses -- OR addresses list is empty --
+//
+// a SetCodeFilter with both lists empty matches all set code transactions. Authorizations whose authority
+// could not be recovered from their signature are invalid and never matched.
+type SetCodeFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Authorities   [][]byte               `protobuf:"bytes,1,rep,name=authorities,proto3" json:"authorities,omitempty"`
+	Addresses     [][]byte               `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCodeFilter) Reset() {
+	*x = SetCodeFilter{}
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCodeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCodeFilter) ProtoMessage() {}
+
+func (x *SetCodeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCodeFilter.ProtoReflect.Descriptor instead.
+func (*SetCodeFilter) Descriptor() ([]byte, []int) {
+	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetCodeFilter) GetAuthorities() [][]byte {
+	if x != nil {
+		return x.Authorities
+	}
+	return nil
+}
+
+func (x *SetCodeFilter) GetAddresses() [][]byte {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+// TrimmedFilter selects the transactions exactly like its CombinedFilter does, then it prunes
+// from each `Call` of the selected transactions the data that is not listed in `keep`:
+//   - `storage_changes`, `balance_changes`, `nonce_changes`, `gas_changes`, `code_changes`,
+//     `account_creations` and `keccak_preimages` are emptied unless their path is in `keep`
+//   - `logs` not matching any of the filter's log_filters are removed from the calls and from the
+//     receipt unless the `logs` path is in `keep`
+//
+// The kept elements are left untouched, so their ordinals and indexes still refer to the full block.
+type TrimmedFilter struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *CombinedFilter        `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Paths, relative to `sf.ethereum.type.v2.Call`, of the fields to keep on the selected transactions,
+	// only the paths listed above are accepted.
+	Keep          *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=keep,proto3" json:"keep,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrimmedFilter) Reset() {
+	*x = TrimmedFilter{}
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrimmedFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrimmedFilter) ProtoMessage() {}
+
+func (x *TrimmedFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrimmedFilter.ProtoReflect.Descriptor instead.
+func (*TrimmedFilter) Descriptor() ([]byte, []int) {
+	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TrimmedFilter) GetFilter() *CombinedFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *TrimmedFilter) GetKeep() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Keep
+	}
+	return nil
+}
+
 // MultiLogFilter concatenates the results of each LogFilter (inclusive OR)
 type MultiLogFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -111,7 +926,7 @@ type MultiLogFilter struct {
 
 func (x *MultiLogFilter) Reset() {
 	*x = MultiLogFilter{}
-	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[1]
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -123,7 +938,7 @@ func (x *MultiLogFilter) String() string {
 func (*MultiLogFilter) ProtoMessage() {}
 
 func (x *MultiLogFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[1]
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -136,7 +951,7 @@ func (x *MultiLogFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultiLogFilter.ProtoReflect.Descriptor instead.
 func (*MultiLogFilter) Descriptor() ([]byte, []int) {
-	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{1}
+	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MultiLogFilter) GetLogFilters() []*LogFilter {
@@ -146,22 +961,26 @@ func (x *MultiLogFilter) GetLogFilters() []*LogFilter {
 	return nil
 }
 
-// LogFilter will match calls where *BOTH*
+// LogFilter will match calls where *ALL* of
 // * the contract address that emits the log is one in the provided addresses -- OR addresses list is empty --
 // * the event signature (topic.0) is one of the provided event_signatures -- OR event_signatures is empty --
+// * the topic at position 1, 2 and 3 is one of the provided topic1, topic2 and topic3 values respectively -- OR the list for this position is empty --
 //
-// a LogFilter with both empty addresses and event_signatures lists is invalid and will fail.
+// a LogFilter with empty addresses, event_signatures, topic1, topic2 and topic3 lists is invalid and will fail.
 type LogFilter struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Addresses       [][]byte               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 	EventSignatures [][]byte               `protobuf:"bytes,2,rep,name=event_signatures,json=eventSignatures,proto3" json:"event_signatures,omitempty"` // corresponds to the keccak of the event signature which is stores in topic.0
+	Topic1          [][]byte               `protobuf:"bytes,3,rep,name=topic1,proto3" json:"topic1,omitempty"`                                          // accepted values for topic.1, 32 bytes each (indexed addresses are left-padded with zeroes)
+	Topic2          [][]byte               `protobuf:"bytes,4,rep,name=topic2,proto3" json:"topic2,omitempty"`                                          // accepted values for topic.2, 32 bytes each (indexed addresses are left-padded with zeroes)
+	Topic3          [][]byte               `protobuf:"bytes,5,rep,name=topic3,proto3" json:"topic3,omitempty"`                                          // accepted values for topic.3, 32 bytes each (indexed addresses are left-padded with zeroes)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *LogFilter) Reset() {
 	*x = LogFilter{}
-	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[2]
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -173,7 +992,7 @@ func (x *LogFilter) String() string {
 func (*LogFilter) ProtoMessage() {}
 
 func (x *LogFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[2]
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -186,7 +1005,7 @@ func (x *LogFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogFilter.ProtoReflect.Descriptor instead.
 func (*LogFilter) Descriptor() ([]byte, []int) {
-	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{2}
+	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *LogFilter) GetAddresses() [][]byte {
@@ -203,6 +1022,27 @@ func (x *LogFilter) GetEventSignatures() [][]byte {
 	return nil
 }
 
+func (x *LogFilter) GetTopic1() [][]byte {
+	if x != nil {
+		return x.Topic1
+	}
+	return nil
+}
+
+func (x *LogFilter) GetTopic2() [][]byte {
+	if x != nil {
+		return x.Topic2
+	}
+	return nil
+}
+
+func (x *LogFilter) GetTopic3() [][]byte {
+	if x != nil {
+		return x.Topic3
+	}
+	return nil
+}
+
 // MultiCallToFilter concatenates the results of each CallToFilter (inclusive OR)
 type MultiCallToFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -213,7 +1053,7 @@ type MultiCallToFilter struct {
 
 func (x *MultiCallToFilter) Reset() {
 	*x = MultiCallToFilter{}
-	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[3]
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -225,7 +1065,7 @@ func (x *MultiCallToFilter) String() string {
 func (*MultiCallToFilter) ProtoMessage() {}
 
 func (x *MultiCallToFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[3]
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -238,7 +1078,7 @@ func (x *MultiCallToFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultiCallToFilter.ProtoReflect.Descriptor instead.
 func (*MultiCallToFilter) Descriptor() ([]byte, []int) {
-	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{3}
+	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *MultiCallToFilter) GetCallFilters() []*CallToFilter {
@@ -248,22 +1088,37 @@ func (x *MultiCallToFilter) GetCallFilters() []*CallToFilter {
 	return nil
 }
 
-// CallToFilter will match calls where *BOTH*
+// CallToFilter will match calls where *ALL* of
 // * the contract address (TO) is one in the provided addresses -- OR addresses list is empty --
 // * the method signature (in 4-bytes format) is one of the provided signatures -- OR signatures is empty --
+// * the call depth is between min_depth and max_depth (inclusive) -- OR max_depth is not set for no upper bound --
+// * the call type is one of the provided call_types -- OR call_types is empty --
+// * the caller (FROM) is one in the provided callers -- OR callers list is empty --
 //
-// a CallToFilter with both empty addresses and signatures lists is invalid and will fail.
+// a CallToFilter with empty addresses, signatures and callers lists is invalid and will fail.
 type CallToFilter struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Addresses     [][]byte               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Signatures    [][]byte               `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Addresses  [][]byte               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Signatures [][]byte               `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// Depth of the call in the transaction's call tree, the top-level call (initiated by the
+	// transaction itself) is at depth 0, setting max_depth to 0 only matches top-level calls.
+	// Only the top-level calls are indexed by depth, other depth bounds don't narrow down the
+	// blocks read from the combined index and are only applied to the transactions of the blocks.
+	MinDepth uint32  `protobuf:"varint,3,opt,name=min_depth,json=minDepth,proto3" json:"min_depth,omitempty"`
+	MaxDepth *uint32 `protobuf:"varint,4,opt,name=max_depth,json=maxDepth,proto3,oneof" json:"max_depth,omitempty"`
+	// Accepted call types, for example DELEGATE to match the calls executing the code of an
+	// implementation contract (the address) on behalf of a proxy.
+	CallTypes []v2.CallType `protobuf:"varint,5,rep,packed,name=call_types,json=callTypes,proto3,enum=sf.ethereum.type.v2.CallType" json:"call_types,omitempty"`
+	// Addresses making the call, for example a vault contract to match all the calls it makes
+	// (when the call is a DELEGATE, the caller is the proxy delegating to the implementation).
+	Callers       [][]byte `protobuf:"bytes,6,rep,name=callers,proto3" json:"callers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CallToFilter) Reset() {
 	*x = CallToFilter{}
-	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[4]
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -275,7 +1130,7 @@ func (x *CallToFilter) String() string {
 func (*CallToFilter) ProtoMessage() {}
 
 func (x *CallToFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[4]
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -288,7 +1143,7 @@ func (x *CallToFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CallToFilter.ProtoReflect.Descriptor instead.
 func (*CallToFilter) Descriptor() ([]byte, []int) {
-	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{4}
+	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CallToFilter) GetAddresses() [][]byte {
@@ -305,6 +1160,120 @@ func (x *CallToFilter) GetSignatures() [][]byte {
 	return nil
 }
 
+func (x *CallToFilter) GetMinDepth() uint32 {
+	if x != nil {
+		return x.MinDepth
+	}
+	return 0
+}
+
+func (x *CallToFilter) GetMaxDepth() uint32 {
+	if x != nil && x.MaxDepth != nil {
+		return *x.MaxDepth
+	}
+	return 0
+}
+
+func (x *CallToFilter) GetCallTypes() []v2.CallType {
+	if x != nil {
+		return x.CallTypes
+	}
+	return nil
+}
+
+func (x *CallToFilter) GetCallers() [][]byte {
+	if x != nil {
+		return x.Callers
+	}
+	return nil
+}
+
+// TransactionFilter will match transactions where *ALL* of
+// * the sender (FROM) is one in the provided from addresses -- OR from list is empty --
+// * the recipient (TO) is one in the provided to addresses -- OR to list is empty --
+// * the transferred value is greater or equal to min_value -- OR min_value is empty --
+// * the transaction type is one of the provided types -- OR types list is empty --
+// * the transaction status is one of the provided statuses -- OR statuses list is empty --
+//
+// a TransactionFilter with all fields empty is invalid and will fail.
+type TransactionFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  [][]byte               `protobuf:"bytes,1,rep,name=from,proto3" json:"from,omitempty"`
+	To    [][]byte               `protobuf:"bytes,2,rep,name=to,proto3" json:"to,omitempty"`
+	// Minimum value in wei that the transaction must transfer, as a big-endian unsigned integer, the
+	// value is not part of the block index so it is only applied when filtering the transactions.
+	MinValue      []byte                      `protobuf:"bytes,3,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	Types         []v2.TransactionTrace_Type  `protobuf:"varint,4,rep,packed,name=types,proto3,enum=sf.ethereum.type.v2.TransactionTrace_Type" json:"types,omitempty"`
+	Statuses      []v2.TransactionTraceStatus `protobuf:"varint,5,rep,packed,name=statuses,proto3,enum=sf.ethereum.type.v2.TransactionTraceStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionFilter) Reset() {
+	*x = TransactionFilter{}
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionFilter) ProtoMessage() {}
+
+func (x *TransactionFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionFilter.ProtoReflect.Descriptor instead.
+func (*TransactionFilter) Descriptor() ([]byte, []int) {
+	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TransactionFilter) GetFrom() [][]byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetTo() [][]byte {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetMinValue() []byte {
+	if x != nil {
+		return x.MinValue
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetTypes() []v2.TransactionTrace_Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetStatuses() []v2.TransactionTraceStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
 // HeaderOnly returns only the block's header and few top-level core information for the block. Useful
 // for cases where no transactions information is required at all.
 //
@@ -331,7 +1300,7 @@ type HeaderOnly struct {
 
 func (x *HeaderOnly) Reset() {
 	*x = HeaderOnly{}
-	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[5]
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -343,7 +1312,7 @@ func (x *HeaderOnly) String() string {
 func (*HeaderOnly) ProtoMessage() {}
 
 func (x *HeaderOnly) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[5]
+	mi := &file_sf_ethereum_transform_v1_transforms_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +1325,7 @@ func (x *HeaderOnly) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeaderOnly.ProtoReflect.Descriptor instead.
 func (*HeaderOnly) Descriptor() ([]byte, []int) {
-	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{5}
+	return file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP(), []int{15}
 }
 
 var File_sf_ethereum_transform_v1_transforms_proto protoreflect.FileDescriptor
@@ -366,49 +1335,249 @@ var file_sf_ethereum_transform_v1_transforms_proto_rawDesc = string([]byte{
 	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73,
 	0x66, 0x6f, 0x72, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x73, 0x66, 0x2e,
 	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
-	0x72, 0x6d, 0x2e, 0x76, 0x31, 0x22, 0xd6, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e,
-	0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x5f,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x72, 0x6d, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x73, 0x66, 0x2f, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x08, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x62,
+	0x69, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0b, 0x6c, 0x6f,
+	0x67, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x49, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0b,
+	0x63, 0x61, 0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x73, 0x65, 0x6e,
+	0x64, 0x41, 0x6c, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x5c, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
 	0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x49,
-	0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x12, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x63,
+	0x0a, 0x16, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x14, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x63, 0x0a, 0x16, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x14, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x6c, 0x0a, 0x19, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x66,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x17, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x4a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x66, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x1b, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x14, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x63, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x14, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a,
+	0x0c, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x6c, 0x6f, 0x62, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x62, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x51, 0x0a, 0x10, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0e, 0x73, 0x65, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x88, 0x08, 0x0a, 0x10, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3f,
+	0x0a, 0x03, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x66,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e, 0x64, 0x12,
+	0x3d, 0x0a, 0x02, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x66,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x72, 0x12, 0x3e,
+	0x0a, 0x03, 0x6e, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x66,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x6e, 0x6f, 0x74, 0x12, 0x44,
+	0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x66, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x5c, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x66,
+	0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x63, 0x0a,
+	0x15, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73,
+	0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x13, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x63, 0x0a, 0x15, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x13, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x6c, 0x0a, 0x18, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x66, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x16, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x63, 0x0a, 0x15, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
 	0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x61, 0x6c, 0x6c, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x63, 0x61,
-	0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x65, 0x6e,
-	0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x73, 0x65, 0x6e, 0x64, 0x41,
-	0x6c, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x56,
-	0x0a, 0x0e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x6f, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x44, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
-	0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x6f, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x54, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
-	0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x11,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x61, 0x6c, 0x6c, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x12, 0x49, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68,
-	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
-	0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x4c, 0x0a, 0x0c,
-	0x43, 0x61, 0x6c, 0x6c, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52,
-	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x0c, 0x0a, 0x0a, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x42, 0x5a, 0x5a, 0x58, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x66, 0x61, 0x73, 0x74, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x68, 0x6f, 0x73, 0x65, 0x2d, 0x65, 0x74,
-	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x62, 0x2f,
-	0x73, 0x66, 0x2f, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x6f, 0x72, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x13, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x0b, 0x62, 0x6c,
+	0x6f, 0x62, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x62, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73,
+	0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x11, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4c, 0x0a, 0x0b, 0x65, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x47, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73,
+	0x22, 0x78, 0x0a, 0x13, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x75, 0x0a, 0x16, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x22, 0x56, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x41, 0x0a, 0x0a, 0x42, 0x6c, 0x6f,
+	0x62, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
+	0x6c, 0x6f, 0x62, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x62, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x4f, 0x0a, 0x0d,
+	0x53, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x81, 0x01,
+	0x0a, 0x0d, 0x54, 0x72, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x40, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69,
+	0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x2e, 0x0a, 0x04, 0x6b, 0x65, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x6b, 0x65, 0x65,
+	0x70, 0x22, 0x56, 0x0a, 0x0e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x6f, 0x67, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x6c,
+	0x6f, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x09, 0x4c, 0x6f,
+	0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x31, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x31, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x32, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x32,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x33, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x33, 0x22, 0x5e, 0x0a, 0x11, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x43, 0x61, 0x6c, 0x6c, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x49, 0x0a,
+	0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x6c, 0x6c, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x63, 0x61, 0x6c,
+	0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x6c,
+	0x6c, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x44,
+	0x65, 0x70, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x74,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65,
+	0x70, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x66, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x32,
+	0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x22, 0xdf, 0x01, 0x0a,
+	0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x73, 0x66, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x0c,
+	0x0a, 0x0a, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x42, 0x5a, 0x5a, 0x58,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x66, 0x61, 0x73, 0x74, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x68, 0x6f, 0x73,
+	0x65, 0x2d, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2f, 0x70, 0x62, 0x2f, 0x73, 0x66, 0x2f, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -423,25 +1592,67 @@ func file_sf_ethereum_transform_v1_transforms_proto_rawDescGZIP() []byte {
 	return file_sf_ethereum_transform_v1_transforms_proto_rawDescData
 }
 
-var file_sf_ethereum_transform_v1_transforms_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_sf_ethereum_transform_v1_transforms_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_sf_ethereum_transform_v1_transforms_proto_goTypes = []any{
-	(*CombinedFilter)(nil),    // 0: sf.ethereum.transform.v1.CombinedFilter
-	(*MultiLogFilter)(nil),    // 1: sf.ethereum.transform.v1.MultiLogFilter
-	(*LogFilter)(nil),         // 2: sf.ethereum.transform.v1.LogFilter
-	(*MultiCallToFilter)(nil), // 3: sf.ethereum.transform.v1.MultiCallToFilter
-	(*CallToFilter)(nil),      // 4: sf.ethereum.transform.v1.CallToFilter
-	(*HeaderOnly)(nil),        // 5: sf.ethereum.transform.v1.HeaderOnly
+	(*CombinedFilter)(nil),         // 0: sf.ethereum.transform.v1.CombinedFilter
+	(*FilterExpression)(nil),       // 1: sf.ethereum.transform.v1.FilterExpression
+	(*FilterExpressions)(nil),      // 2: sf.ethereum.transform.v1.FilterExpressions
+	(*StorageChangeFilter)(nil),    // 3: sf.ethereum.transform.v1.StorageChangeFilter
+	(*BalanceChangeFilter)(nil),    // 4: sf.ethereum.transform.v1.BalanceChangeFilter
+	(*ContractCreationFilter)(nil), // 5: sf.ethereum.transform.v1.ContractCreationFilter
+	(*ValueTransferFilter)(nil),    // 6: sf.ethereum.transform.v1.ValueTransferFilter
+	(*BlobFilter)(nil),             // 7: sf.ethereum.transform.v1.BlobFilter
+	(*SetCodeFilter)(nil),          // 8: sf.ethereum.transform.v1.SetCodeFilter
+	(*TrimmedFilter)(nil),          // 9: sf.ethereum.transform.v1.TrimmedFilter
+	(*MultiLogFilter)(nil),         // 10: sf.ethereum.transform.v1.MultiLogFilter
+	(*LogFilter)(nil),              // 11: sf.ethereum.transform.v1.LogFilter
+	(*MultiCallToFilter)(nil),      // 12: sf.ethereum.transform.v1.MultiCallToFilter
+	(*CallToFilter)(nil),           // 13: sf.ethereum.transform.v1.CallToFilter
+	(*TransactionFilter)(nil),      // 14: sf.ethereum.transform.v1.TransactionFilter
+	(*HeaderOnly)(nil),             // 15: sf.ethereum.transform.v1.HeaderOnly
+	(v2.BalanceChange_Reason)(0),   // 16: sf.ethereum.type.v2.BalanceChange.Reason
+	(*fieldmaskpb.FieldMask)(nil),  // 17: google.protobuf.FieldMask
+	(v2.CallType)(0),               // 18: sf.ethereum.type.v2.CallType
+	(v2.TransactionTrace_Type)(0),  // 19: sf.ethereum.type.v2.TransactionTrace.Type
+	(v2.TransactionTraceStatus)(0), // 20: sf.ethereum.type.v2.TransactionTraceStatus
 }
 var file_sf_ethereum_transform_v1_transforms_proto_depIdxs = []int32{
-	2, // 0: sf.ethereum.transform.v1.CombinedFilter.log_filters:type_name -> sf.ethereum.transform.v1.LogFilter
-	4, // 1: sf.ethereum.transform.v1.CombinedFilter.call_filters:type_name -> sf.ethereum.transform.v1.CallToFilter
-	2, // 2: sf.ethereum.transform.v1.MultiLogFilter.log_filters:type_name -> sf.ethereum.transform.v1.LogFilter
-	4, // 3: sf.ethereum.transform.v1.MultiCallToFilter.call_filters:type_name -> sf.ethereum.transform.v1.CallToFilter
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	11, // 0: sf.ethereum.transform.v1.CombinedFilter.log_filters:type_name -> sf.ethereum.transform.v1.LogFilter
+	13, // 1: sf.ethereum.transform.v1.CombinedFilter.call_filters:type_name -> sf.ethereum.transform.v1.CallToFilter
+	14, // 2: sf.ethereum.transform.v1.CombinedFilter.transaction_filters:type_name -> sf.ethereum.transform.v1.TransactionFilter
+	3,  // 3: sf.ethereum.transform.v1.CombinedFilter.storage_change_filters:type_name -> sf.ethereum.transform.v1.StorageChangeFilter
+	4,  // 4: sf.ethereum.transform.v1.CombinedFilter.balance_change_filters:type_name -> sf.ethereum.transform.v1.BalanceChangeFilter
+	5,  // 5: sf.ethereum.transform.v1.CombinedFilter.contract_creation_filters:type_name -> sf.ethereum.transform.v1.ContractCreationFilter
+	1,  // 6: sf.ethereum.transform.v1.CombinedFilter.expression:type_name -> sf.ethereum.transform.v1.FilterExpression
+	6,  // 7: sf.ethereum.transform.v1.CombinedFilter.value_transfer_filters:type_name -> sf.ethereum.transform.v1.ValueTransferFilter
+	7,  // 8: sf.ethereum.transform.v1.CombinedFilter.blob_filters:type_name -> sf.ethereum.transform.v1.BlobFilter
+	8,  // 9: sf.ethereum.transform.v1.CombinedFilter.set_code_filters:type_name -> sf.ethereum.transform.v1.SetCodeFilter
+	2,  // 10: sf.ethereum.transform.v1.FilterExpression.and:type_name -> sf.ethereum.transform.v1.FilterExpressions
+	2,  // 11: sf.ethereum.transform.v1.FilterExpression.or:type_name -> sf.ethereum.transform.v1.FilterExpressions
+	1,  // 12: sf.ethereum.transform.v1.FilterExpression.not:type_name -> sf.ethereum.transform.v1.FilterExpression
+	11, // 13: sf.ethereum.transform.v1.FilterExpression.log_filter:type_name -> sf.ethereum.transform.v1.LogFilter
+	13, // 14: sf.ethereum.transform.v1.FilterExpression.call_filter:type_name -> sf.ethereum.transform.v1.CallToFilter
+	14, // 15: sf.ethereum.transform.v1.FilterExpression.transaction_filter:type_name -> sf.ethereum.transform.v1.TransactionFilter
+	3,  // 16: sf.ethereum.transform.v1.FilterExpression.storage_change_filter:type_name -> sf.ethereum.transform.v1.StorageChangeFilter
+	4,  // 17: sf.ethereum.transform.v1.FilterExpression.balance_change_filter:type_name -> sf.ethereum.transform.v1.BalanceChangeFilter
+	5,  // 18: sf.ethereum.transform.v1.FilterExpression.contract_creation_filter:type_name -> sf.ethereum.transform.v1.ContractCreationFilter
+	6,  // 19: sf.ethereum.transform.v1.FilterExpression.value_transfer_filter:type_name -> sf.ethereum.transform.v1.ValueTransferFilter
+	7,  // 20: sf.ethereum.transform.v1.FilterExpression.blob_filter:type_name -> sf.ethereum.transform.v1.BlobFilter
+	8,  // 21: sf.ethereum.transform.v1.FilterExpression.set_code_filter:type_name -> sf.ethereum.transform.v1.SetCodeFilter
+	1,  // 22: sf.ethereum.transform.v1.FilterExpressions.expressions:type_name -> sf.ethereum.transform.v1.FilterExpression
+	16, // 23: sf.ethereum.transform.v1.BalanceChangeFilter.reasons:type_name -> sf.ethereum.type.v2.BalanceChange.Reason
+	0,  // 24: sf.ethereum.transform.v1.TrimmedFilter.filter:type_name -> sf.ethereum.transform.v1.CombinedFilter
+	17, // 25: sf.ethereum.transform.v1.TrimmedFilter.keep:type_name -> google.protobuf.FieldMask
+	11, // 26: sf.ethereum.transform.v1.MultiLogFilter.log_filters:type_name -> sf.ethereum.transform.v1.LogFilter
+	13, // 27: sf.ethereum.transform.v1.MultiCallToFilter.call_filters:type_name -> sf.ethereum.transform.v1.CallToFilter
+	18, // 28: sf.ethereum.transform.v1.CallToFilter.call_types:type_name -> sf.ethereum.type.v2.CallType
+	19, // 29: sf.ethereum.transform.v1.TransactionFilter.types:type_name -> sf.ethereum.type.v2.TransactionTrace.Type
+	20, // 30: sf.ethereum.transform.v1.TransactionFilter.statuses:type_name -> sf.ethereum.type.v2.TransactionTraceStatus
+	31, // [31:31] is the sub-list for method output_type
+	31, // [31:31] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_sf_ethereum_transform_v1_transforms_proto_init() }
@@ -449,13 +1660,28 @@ func file_sf_ethereum_transform_v1_transforms_proto_init() {
 	if File_sf_ethereum_transform_v1_transforms_proto != nil {
 		return
 	}
+	file_sf_ethereum_transform_v1_transforms_proto_msgTypes[1].OneofWrappers = []any{
+		(*FilterExpression_And)(nil),
+		(*FilterExpression_Or)(nil),
+		(*FilterExpression_Not)(nil),
+		(*FilterExpression_LogFilter)(nil),
+		(*FilterExpression_CallFilter)(nil),
+		(*FilterExpression_TransactionFilter)(nil),
+		(*FilterExpression_StorageChangeFilter)(nil),
+		(*FilterExpression_BalanceChangeFilter)(nil),
+		(*FilterExpression_ContractCreationFilter)(nil),
+		(*FilterExpression_ValueTransferFilter)(nil),
+		(*FilterExpression_BlobFilter)(nil),
+		(*FilterExpression_SetCodeFilter)(nil),
+	}
+	file_sf_ethereum_transform_v1_transforms_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sf_ethereum_transform_v1_transforms_proto_rawDesc), len(file_sf_ethereum_transform_v1_transforms_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},